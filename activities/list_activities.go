@@ -28,14 +28,14 @@ import (
 )
 
 type ListActivitiesRequest struct {
-	PortfolioId                 string                  `json:"portfolio_id"`
-	Symbols                     []string                `json:"symbols"`
-	Categories                  []string                `json:"categories"`
-	Statuses                    []string                `json:"statuses"`
-	Start                       time.Time               `json:"start_time"`
-	End                         time.Time               `json:"end_time"`
-	GetNetworkUnifiedActivities bool                    `json:"get_network_unified_activities,omitempty"`
-	Pagination                  *model.PaginationParams `json:"pagination_params"`
+	PortfolioId                 string                   `json:"portfolio_id"`
+	Symbols                     []string                 `json:"symbols"`
+	Categories                  []model.ActivityCategory `json:"categories"`
+	Statuses                    []model.ActivityStatus   `json:"statuses"`
+	Start                       time.Time                `json:"start_time"`
+	End                         time.Time                `json:"end_time"`
+	GetNetworkUnifiedActivities bool                     `json:"get_network_unified_activities,omitempty"`
+	Pagination                  *model.PaginationParams  `json:"pagination_params"`
 }
 
 type ListActivitiesResponse struct {
@@ -70,6 +70,53 @@ func (r *ListActivitiesResponse) Iterator() *model.PageIterator[*ListActivitiesR
 	)
 }
 
+// NewResumableListActivities returns a ResumableIterator that saves its progress in store under key.
+// If a checkpoint already exists for key, iteration resumes from it instead of request.
+func NewResumableListActivities(
+	service ActivitiesService,
+	request *ListActivitiesRequest,
+	key string,
+	store model.CheckpointStore,
+) *model.ResumableIterator[ListActivitiesRequest, *ListActivitiesResponse, *model.Activity] {
+	return model.NewResumableIterator(
+		key,
+		store,
+		request,
+		service.ListActivities,
+		func(resp *ListActivitiesResponse) []*model.Activity {
+			return resp.Activities
+		},
+		func(req *ListActivitiesRequest, cursor string) *ListActivitiesRequest {
+			next := *req
+			next.Pagination = model.PrepareNextPagination(req.Pagination, cursor)
+			return &next
+		},
+	).WithConfig(service.ServiceConfig())
+}
+
+// BackfillActivities lists activities between request.Start and request.End by paging time windows
+// concurrently. Start is required and a zero End means now. Results are deduped by id and sorted by time.
+func BackfillActivities(
+	ctx context.Context,
+	service ActivitiesService,
+	request *ListActivitiesRequest,
+	config *model.BackfillConfig,
+) ([]*model.Activity, error) {
+	return model.BackfillList(ctx, model.ListBackfill[ListActivitiesRequest, *ListActivitiesResponse, *model.Activity]{
+		Start: request.Start,
+		End:   request.End,
+		Window: func(start, end time.Time) *ListActivitiesRequest {
+			window := *request
+			window.Start, window.End, window.Pagination = start, end, model.FirstPage(request.Pagination)
+			return &window
+		},
+		List:  service.ListActivities,
+		Items: func(resp *ListActivitiesResponse) []*model.Activity { return resp.Activities },
+		Id:    func(a *model.Activity) string { return a.Id },
+		Time:  func(a *model.Activity) time.Time { return a.Created.Time },
+	}, config)
+}
+
 func (s *activitiesServiceImpl) ListActivities(
 	ctx context.Context,
 	request *ListActivitiesRequest,
@@ -94,11 +141,11 @@ func (s *activitiesServiceImpl) ListActivities(
 	}
 
 	for _, v := range request.Categories {
-		queryParams = core.AppendHttpQueryParam(queryParams, "categories", v)
+		queryParams = core.AppendHttpQueryParam(queryParams, "categories", string(v))
 	}
 
 	for _, v := range request.Statuses {
-		queryParams = core.AppendHttpQueryParam(queryParams, "statuses", v)
+		queryParams = core.AppendHttpQueryParam(queryParams, "statuses", string(v))
 	}
 
 	if request.GetNetworkUnifiedActivities {
@@ -28,15 +28,15 @@ import (
 )
 
 type ListEntityActivitiesRequest struct {
-	EntityId                    string                  `json:"entity_id"`
-	ActivityLevel               string                  `json:"activity_level"`
-	Symbols                     []string                `json:"symbols"`
-	Categories                  []string                `json:"categories"`
-	Statuses                    []string                `json:"statuses"`
-	StartTime                   time.Time               `json:"start_time"`
-	EndTime                     time.Time               `json:"end_time"`
-	GetNetworkUnifiedActivities bool                    `json:"get_network_unified_activities,omitempty"`
-	Pagination                  *model.PaginationParams `json:"pagination_params"`
+	EntityId                    string                   `json:"entity_id"`
+	ActivityLevel               string                   `json:"activity_level"`
+	Symbols                     []string                 `json:"symbols"`
+	Categories                  []model.ActivityCategory `json:"categories"`
+	Statuses                    []model.ActivityStatus   `json:"statuses"`
+	StartTime                   time.Time                `json:"start_time"`
+	EndTime                     time.Time                `json:"end_time"`
+	GetNetworkUnifiedActivities bool                     `json:"get_network_unified_activities,omitempty"`
+	Pagination                  *model.PaginationParams  `json:"pagination_params"`
 }
 
 type ListEntityActivitiesResponse struct {
@@ -99,11 +99,11 @@ func (s *activitiesServiceImpl) ListEntityActivities(
 	}
 
 	for _, v := range request.Categories {
-		queryParams = core.AppendHttpQueryParam(queryParams, "categories", v)
+		queryParams = core.AppendHttpQueryParam(queryParams, "categories", string(v))
 	}
 
 	for _, v := range request.Statuses {
-		queryParams = core.AppendHttpQueryParam(queryParams, "statuses", v)
+		queryParams = core.AppendHttpQueryParam(queryParams, "statuses", string(v))
 	}
 
 	if request.GetNetworkUnifiedActivities {
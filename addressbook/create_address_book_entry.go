@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/coinbase-samples/core-go"
+	"github.com/coinbase-samples/prime-sdk-go/blockchain"
 	"github.com/coinbase-samples/prime-sdk-go/client"
 )
 
@@ -33,6 +34,16 @@ type CreateAddressBookEntryRequest struct {
 	ChainIds          []string `json:"chain_ids,omitempty"`
 }
 
+// Validate checks the address and account identifier offline. Entries with chain ids are EVM
+// addresses; otherwise the network is resolved from the symbol.
+func (r *CreateAddressBookEntryRequest) Validate() error {
+	if len(r.ChainIds) > 0 {
+		evm := &blockchain.Network{Name: "evm", Family: blockchain.FamilyEvm}
+		return evm.Validate(r.Address, r.AccountIdentifier)
+	}
+	return blockchain.ValidateAddress(nil, r.Symbol, r.Address, r.AccountIdentifier)
+}
+
 type CreateAddressBookEntryResponse struct {
 	ActivityId         string                         `json:"activity_id"`
 	Type               string                         `json:"activity_type"`
@@ -45,6 +56,10 @@ func (s *addressBookServiceImpl) CreateAddressBookEntry(
 	request *CreateAddressBookEntryRequest,
 ) (*CreateAddressBookEntryResponse, error) {
 
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/portfolios/%s/address_book", request.PortfolioId)
 
 	response := &CreateAddressBookEntryResponse{Request: request}
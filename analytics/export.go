@@ -0,0 +1,108 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analytics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+var csvHeader = []string{
+	"key",
+	"fills",
+	"quantity",
+	"buy_quantity",
+	"sell_quantity",
+	"notional",
+	"buy_notional",
+	"sell_notional",
+	"vwap",
+	"buy_vwap",
+	"sell_vwap",
+	"commission",
+	"venue_fees",
+	"ces_commission",
+	"commission_total",
+	"commission_client",
+	"commission_venue",
+	"commission_ces",
+	"commission_financing",
+	"commission_regulatory",
+	"commission_clearing",
+	"first",
+	"last",
+}
+
+// WriteCSV writes summaries as CSV with a header row. Amounts are written in full precision.
+func WriteCSV(w io.Writer, summaries []*FillSummary) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, s := range summaries {
+		d := s.CommissionDetail
+		row := []string{
+			s.Key,
+			strconv.Itoa(s.Fills),
+			s.Quantity.String(),
+			s.BuyQuantity.String(),
+			s.SellQuantity.String(),
+			s.Notional.String(),
+			s.BuyNotional.String(),
+			s.SellNotional.String(),
+			s.Vwap.String(),
+			s.BuyVwap.String(),
+			s.SellVwap.String(),
+			s.Commission.String(),
+			s.VenueFees.String(),
+			s.CesCommission.String(),
+			d.Total.String(),
+			d.Client.String(),
+			d.Venue.String(),
+			d.Ces.String(),
+			d.Financing.String(),
+			d.Regulatory.String(),
+			d.Clearing.String(),
+			formatTime(s.First),
+			formatTime(s.Last),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes summaries as an indented JSON array. Amounts are encoded as strings.
+func WriteJSON(w io.Writer, summaries []*FillSummary) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(summaries)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
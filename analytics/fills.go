@@ -0,0 +1,201 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package analytics aggregates fills returned by ListPortfolioFills and ListOrderFills
+// into quantity, notional, VWAP and fee summaries using decimal math.
+package analytics
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+// KeyFunc returns the group a fill belongs to
+type KeyFunc func(fill *model.OrderFill) string
+
+// ByOrder groups fills by order id
+func ByOrder(fill *model.OrderFill) string { return fill.OrderId }
+
+// ByProduct groups fills by product id
+func ByProduct(fill *model.OrderFill) string { return fill.ProductId }
+
+// ByVenue groups fills by venue
+func ByVenue(fill *model.OrderFill) string { return fill.Venue }
+
+// ByDay groups fills by their UTC date (YYYY-MM-DD)
+func ByDay(fill *model.OrderFill) string { return fill.Time.UTC().Format(time.DateOnly) }
+
+// ByUser groups fills by the user that placed their order. Fills do not carry a user,
+// so it is looked up from orders by order id; fills of unknown orders are grouped under "".
+func ByUser(orders []*model.Order) KeyFunc {
+	users := make(map[string]string, len(orders))
+	for _, o := range orders {
+		users[o.Id] = o.UserId
+	}
+	return func(fill *model.OrderFill) string {
+		return users[fill.OrderId]
+	}
+}
+
+// CommissionBreakdown totals the CommissionDetailTotal components
+type CommissionBreakdown struct {
+	Total      decimal.Decimal `json:"total"`
+	Client     decimal.Decimal `json:"client"`
+	Venue      decimal.Decimal `json:"venue"`
+	Ces        decimal.Decimal `json:"ces"`
+	Financing  decimal.Decimal `json:"financing"`
+	Regulatory decimal.Decimal `json:"regulatory"`
+	Clearing   decimal.Decimal `json:"clearing"`
+}
+
+// FillSummary aggregates the fills of one group
+type FillSummary struct {
+	Key          string          `json:"key"`
+	Fills        int             `json:"fills"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	BuyQuantity  decimal.Decimal `json:"buy_quantity"`
+	SellQuantity decimal.Decimal `json:"sell_quantity"`
+	Notional     decimal.Decimal `json:"notional"`
+	BuyNotional  decimal.Decimal `json:"buy_notional"`
+	SellNotional decimal.Decimal `json:"sell_notional"`
+	// Vwap is Notional divided by Quantity across both sides, so it is only a meaningful price for
+	// one-sided groups such as ByOrder. Use BuyVwap and SellVwap for groups that mix sides.
+	Vwap             decimal.Decimal     `json:"vwap"`
+	BuyVwap          decimal.Decimal     `json:"buy_vwap"`
+	SellVwap         decimal.Decimal     `json:"sell_vwap"`
+	Commission       decimal.Decimal     `json:"commission"`
+	VenueFees        decimal.Decimal     `json:"venue_fees"`
+	CesCommission    decimal.Decimal     `json:"ces_commission"`
+	CommissionDetail CommissionBreakdown `json:"commission_detail"`
+	First            time.Time           `json:"first"`
+	Last             time.Time           `json:"last"`
+}
+
+// Aggregate groups fills with key and returns one summary per group, sorted by key.
+// A fill's notional is its FilledValue, or FilledQuantity times Price when FilledValue is empty.
+// Empty amounts count as zero; malformed amounts return an error naming the fill.
+func Aggregate(fills []*model.OrderFill, key KeyFunc) ([]*FillSummary, error) {
+	groups := make(map[string]*FillSummary)
+
+	for _, f := range fills {
+		k := key(f)
+		s, ok := groups[k]
+		if !ok {
+			s = &FillSummary{Key: k}
+			groups[k] = s
+		}
+		if err := s.add(f); err != nil {
+			return nil, fmt.Errorf("invalid fill %s: %w", f.Id, err)
+		}
+	}
+
+	summaries := make([]*FillSummary, 0, len(groups))
+	for _, s := range groups {
+		s.Vwap = vwap(s.Notional, s.Quantity)
+		s.BuyVwap = vwap(s.BuyNotional, s.BuyQuantity)
+		s.SellVwap = vwap(s.SellNotional, s.SellQuantity)
+		summaries = append(summaries, s)
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].Key < summaries[j].Key })
+
+	return summaries, nil
+}
+
+// vwap returns notional divided by quantity, or zero without quantity
+func vwap(notional, quantity decimal.Decimal) decimal.Decimal {
+	if !quantity.IsPositive() {
+		return decimal.Zero
+	}
+	return notional.Div(quantity)
+}
+
+func (s *FillSummary) add(f *model.OrderFill) error {
+	// num keeps the first parse error so the amounts can be read in one pass
+	var err error
+	num := func(parse func() (decimal.Decimal, error)) decimal.Decimal {
+		if err != nil {
+			return decimal.Zero
+		}
+		var n decimal.Decimal
+		n, err = parse()
+		return n
+	}
+
+	qty := num(f.FilledQuantityNum)
+	value := num(f.FilledValueNum)
+	price := num(f.PriceNum)
+	commission := num(f.CommissionNum)
+	venueFees := num(f.VenueFeesNum)
+	ces := num(f.CesCommissionNum)
+
+	var detail CommissionBreakdown
+	if d := f.CommissionDetailTotal; d != nil {
+		detail = CommissionBreakdown{
+			Total:      num(d.TotalCommissionNum),
+			Client:     num(d.ClientCommissionNum),
+			Venue:      num(d.VenueCommissionNum),
+			Ces:        num(d.CesCommissionNum),
+			Financing:  num(d.FinancingCommissionNum),
+			Regulatory: num(d.RegulatoryCommissionNum),
+			Clearing:   num(d.ClearingCommissionNum),
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(f.FilledValue) == 0 {
+		value = qty.Mul(price)
+	}
+
+	s.Fills++
+	s.Quantity = s.Quantity.Add(qty)
+	switch f.Side {
+	case string(model.OrderSideBuy):
+		s.BuyQuantity = s.BuyQuantity.Add(qty)
+		s.BuyNotional = s.BuyNotional.Add(value)
+	case string(model.OrderSideSell):
+		s.SellQuantity = s.SellQuantity.Add(qty)
+		s.SellNotional = s.SellNotional.Add(value)
+	}
+	s.Notional = s.Notional.Add(value)
+	s.Commission = s.Commission.Add(commission)
+	s.VenueFees = s.VenueFees.Add(venueFees)
+	s.CesCommission = s.CesCommission.Add(ces)
+
+	s.CommissionDetail.Total = s.CommissionDetail.Total.Add(detail.Total)
+	s.CommissionDetail.Client = s.CommissionDetail.Client.Add(detail.Client)
+	s.CommissionDetail.Venue = s.CommissionDetail.Venue.Add(detail.Venue)
+	s.CommissionDetail.Ces = s.CommissionDetail.Ces.Add(detail.Ces)
+	s.CommissionDetail.Financing = s.CommissionDetail.Financing.Add(detail.Financing)
+	s.CommissionDetail.Regulatory = s.CommissionDetail.Regulatory.Add(detail.Regulatory)
+	s.CommissionDetail.Clearing = s.CommissionDetail.Clearing.Add(detail.Clearing)
+
+	if !f.Time.IsZero() {
+		if s.First.IsZero() || f.Time.Before(s.First) {
+			s.First = f.Time.Time
+		}
+		if f.Time.After(s.Last) {
+			s.Last = f.Time.Time
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analytics
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+var day = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testFills() []*model.OrderFill {
+	return []*model.OrderFill{
+		{
+			Id: "f1", OrderId: "o1", ProductId: "BTC-USD", Side: "BUY", Venue: "CB",
+			FilledQuantity: "1", Price: "100", Commission: "0.1", VenueFees: "0.05", CesCommission: "0.01",
+			Time:                  model.NewTimestamp(day),
+			CommissionDetailTotal: &model.CommissionDetailTotal{TotalCommission: "0.1", ClientCommission: "0.07", VenueCommission: "0.03"},
+		},
+		{
+			Id: "f2", OrderId: "o1", ProductId: "BTC-USD", Side: "BUY", Venue: "LP",
+			FilledQuantity: "3", FilledValue: "330", Price: "110", Commission: "0.3",
+			Time: model.NewTimestamp(day.Add(time.Hour)),
+		},
+		{
+			Id: "f3", OrderId: "o2", ProductId: "ETH-USD", Side: "SELL", Venue: "CB",
+			FilledQuantity: "2", Price: "10",
+			Time: model.NewTimestamp(day.Add(24 * time.Hour)),
+		},
+	}
+}
+
+func TestAggregateByProduct(t *testing.T) {
+	summaries, err := Aggregate(testFills(), ByProduct)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(summaries) != 2 || summaries[0].Key != "BTC-USD" || summaries[1].Key != "ETH-USD" {
+		t.Fatalf("unexpected summaries: %+v", summaries)
+	}
+
+	btc := summaries[0]
+	checks := map[string]string{
+		"quantity":          btc.Quantity.String(),
+		"notional":          btc.Notional.String(),
+		"vwap":              btc.Vwap.String(),
+		"commission":        btc.Commission.String(),
+		"venue fees":        btc.VenueFees.String(),
+		"client commission": btc.CommissionDetail.Client.String(),
+	}
+	want := map[string]string{
+		"quantity":          "4",
+		"notional":          "430",
+		"vwap":              "107.5",
+		"commission":        "0.4",
+		"venue fees":        "0.05",
+		"client commission": "0.07",
+	}
+	for field, got := range checks {
+		if got != want[field] {
+			t.Errorf("%s = %s; want %s", field, got, want[field])
+		}
+	}
+	if btc.Fills != 2 || !btc.First.Equal(day) || !btc.Last.Equal(day.Add(time.Hour)) {
+		t.Errorf("unexpected counts or times: %+v", btc)
+	}
+	if !summaries[1].SellQuantity.Equal(summaries[1].Quantity) {
+		t.Errorf("expected ETH to be all sells: %+v", summaries[1])
+	}
+}
+
+func TestAggregateVwapPerSide(t *testing.T) {
+	fills := []*model.OrderFill{
+		{Id: "b1", ProductId: "BTC-USD", Side: "BUY", FilledQuantity: "1", Price: "100"},
+		{Id: "b2", ProductId: "BTC-USD", Side: "BUY", FilledQuantity: "1", Price: "110"},
+		{Id: "s1", ProductId: "BTC-USD", Side: "SELL", FilledQuantity: "2", Price: "120"},
+	}
+
+	summaries, err := Aggregate(fills, ByProduct)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s := summaries[0]
+	if !s.BuyNotional.Equal(decimal.NewFromInt(210)) || !s.SellNotional.Equal(decimal.NewFromInt(240)) {
+		t.Errorf("unexpected notionals: buy %s, sell %s", s.BuyNotional, s.SellNotional)
+	}
+	if !s.BuyVwap.Equal(decimal.NewFromInt(105)) || !s.SellVwap.Equal(decimal.NewFromInt(120)) {
+		t.Errorf("unexpected vwaps: buy %s, sell %s", s.BuyVwap, s.SellVwap)
+	}
+}
+
+func TestAggregateKeys(t *testing.T) {
+	orders := []*model.Order{{Id: "o1", UserId: "alice"}, {Id: "o2", UserId: "bob"}}
+
+	cases := []struct {
+		description string
+		key         KeyFunc
+		want        []string
+	}{
+		{"Order", ByOrder, []string{"o1", "o2"}},
+		{"Venue", ByVenue, []string{"CB", "LP"}},
+		{"Day", ByDay, []string{"2026-03-01", "2026-03-02"}},
+		{"User", ByUser(orders), []string{"alice", "bob"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			summaries, err := Aggregate(testFills(), tc.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(summaries) != len(tc.want) {
+				t.Fatalf("got %d groups; want %v", len(summaries), tc.want)
+			}
+			for i, s := range summaries {
+				if s.Key != tc.want[i] {
+					t.Errorf("group %d = %q; want %q", i, s.Key, tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAggregateRejectsMalformedAmounts(t *testing.T) {
+	fills := []*model.OrderFill{{Id: "bad", FilledQuantity: "one"}}
+	if _, err := Aggregate(fills, ByOrder); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestExport(t *testing.T) {
+	summaries, err := Aggregate(testFills(), ByOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, summaries); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 || rows[0][0] != "key" || rows[1][8] != "107.5" || len(rows[1]) != len(csvHeader) {
+		t.Errorf("unexpected csv: %v", rows)
+	}
+
+	buf.Reset()
+	if err := WriteJSON(&buf, summaries); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []*FillSummary
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 2 || !decoded[0].Vwap.Equal(summaries[0].Vwap) {
+		t.Errorf("unexpected json round trip: %s", buf.String())
+	}
+}
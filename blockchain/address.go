@@ -0,0 +1,337 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package blockchain validates destination addresses offline before they are sent to Prime: Bitcoin
+// and Litecoin base58 and bech32/bech32m, EVM hex with EIP-55 checksums, Solana, XRP and Stellar
+// addresses, and the destination tag or memo rules of their account identifiers.
+package blockchain
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+// AddressError reports a destination address or account identifier that is not valid for its network
+type AddressError struct {
+	Network string
+	Address string
+	Reason  string
+}
+
+func (e *AddressError) Error() string {
+	return fmt.Sprintf("invalid %s address %q: %s", e.Network, e.Address, e.Reason)
+}
+
+// ValidateAddress checks address and accountIdentifier offline against the format of the network
+// resolved from network and symbol. Networks that cannot be resolved are not validated and return nil.
+func ValidateAddress(network *model.NetworkDetails, symbol, address, accountIdentifier string) error {
+	n, ok := Lookup(network, symbol)
+	if !ok {
+		return nil
+	}
+	return n.Validate(address, accountIdentifier)
+}
+
+// ValidateBlockchainAddress validates a withdrawal destination for symbol, using its network when set
+func ValidateBlockchainAddress(address *model.BlockchainAddress, symbol string) error {
+	if address == nil {
+		return nil
+	}
+	return ValidateAddress(address.Network, symbol, address.Address, address.AccountIdentifier)
+}
+
+// ValidateOnchainAddress validates an address of an onchain address group of the given network type
+func ValidateOnchainAddress(networkType model.OnchainNetworkType, address string) error {
+	family, ok := OnchainFamily(networkType)
+	if !ok {
+		return nil
+	}
+	n := &Network{Name: strings.ToLower(string(family)), Family: family}
+	return n.Validate(address, "")
+}
+
+// OnchainFamily returns the family of the networks an onchain address group of the given type covers
+func OnchainFamily(networkType model.OnchainNetworkType) (Family, bool) {
+	switch networkType {
+	case model.OnchainNetworkTypeEvm:
+		return FamilyEvm, true
+	case model.OnchainNetworkTypeSolana:
+		return FamilySolana, true
+	default:
+		return "", false
+	}
+}
+
+// Family groups networks that share an address format
+type Family string
+
+const (
+	FamilyBitcoin  Family = "BITCOIN"
+	FamilyLitecoin Family = "LITECOIN"
+	FamilyEvm      Family = "EVM"
+	FamilySolana   Family = "SOLANA"
+	FamilyXrp      Family = "XRP"
+	FamilyStellar  Family = "STELLAR"
+)
+
+// Network is a blockchain network with a known address format
+type Network struct {
+	// Name is the network id, e.g. bitcoin or base
+	Name    string
+	Family  Family
+	Testnet bool
+}
+
+var networkFamilies = map[string]Family{
+	"bitcoin":   FamilyBitcoin,
+	"litecoin":  FamilyLitecoin,
+	"ethereum":  FamilyEvm,
+	"base":      FamilyEvm,
+	"polygon":   FamilyEvm,
+	"arbitrum":  FamilyEvm,
+	"optimism":  FamilyEvm,
+	"avalanche": FamilyEvm,
+	"avacchain": FamilyEvm,
+	"bnb":       FamilyEvm,
+	"linea":     FamilyEvm,
+	"solana":    FamilySolana,
+	"ripple":    FamilyXrp,
+	"xrp":       FamilyXrp,
+	"stellar":   FamilyStellar,
+}
+
+// nativeNetworks maps the native asset of a network to its network id. Tokens that exist on several
+// networks, such as USDC, are deliberately absent and need NetworkDetails to be validated.
+var nativeNetworks = map[string]string{
+	"BTC": "bitcoin",
+	"LTC": "litecoin",
+	"ETH": "ethereum",
+	"POL": "polygon",
+	"SOL": "solana",
+	"XRP": "xrp",
+	"XLM": "stellar",
+}
+
+// Lookup resolves the network of a destination from its network details or, when those are absent,
+// from the native asset symbol. Network ids may carry the type as a suffix, e.g. ethereum-mainnet.
+func Lookup(network *model.NetworkDetails, symbol string) (*Network, bool) {
+	var id, networkType string
+	if network != nil && len(network.Id) > 0 {
+		id, networkType = strings.ToLower(network.Id), strings.ToLower(network.Type)
+		if base, suffix, found := strings.Cut(id, "-"); found {
+			id = base
+			if len(networkType) == 0 {
+				networkType = suffix
+			}
+		}
+	} else {
+		var ok bool
+		if id, ok = nativeNetworks[strings.ToUpper(symbol)]; !ok {
+			return nil, false
+		}
+	}
+
+	family, ok := networkFamilies[id]
+	if !ok {
+		return nil, false
+	}
+	return &Network{Name: id, Family: family, Testnet: len(networkType) > 0 && networkType != "mainnet"}, true
+}
+
+// Validate checks address and accountIdentifier against the format of the network
+func (n *Network) Validate(address, accountIdentifier string) error {
+	if err := n.validateAddress(address); err != nil {
+		return &AddressError{Network: n.Name, Address: address, Reason: err.Error()}
+	}
+	if err := n.validateAccountIdentifier(accountIdentifier); err != nil {
+		return &AddressError{Network: n.Name, Address: address, Reason: err.Error()}
+	}
+	return nil
+}
+
+func (n *Network) validateAddress(address string) error {
+	if len(address) == 0 {
+		return errors.New("address is required")
+	}
+
+	switch n.Family {
+	case FamilyBitcoin:
+		if n.Testnet {
+			return validateUtxoAddress(address, []string{"tb", "bcrt"}, []byte{0x6f, 0xc4})
+		}
+		return validateUtxoAddress(address, []string{"bc"}, []byte{0x00, 0x05})
+	case FamilyLitecoin:
+		if n.Testnet {
+			return validateUtxoAddress(address, []string{"tltc", "rltc"}, []byte{0x6f, 0xc4, 0x3a})
+		}
+		return validateUtxoAddress(address, []string{"ltc"}, []byte{0x30, 0x32, 0x05})
+	case FamilyEvm:
+		return validateEvmAddress(address)
+	case FamilySolana:
+		b, err := base58Decode(address, bitcoinAlphabet)
+		if err != nil {
+			return err
+		}
+		if len(b) != 32 {
+			return fmt.Errorf("expected 32 bytes, got %d", len(b))
+		}
+	case FamilyXrp:
+		version, payload, err := base58CheckDecode(address, rippleAlphabet)
+		if err != nil {
+			return err
+		}
+		if version != 0x00 || len(payload) != 20 {
+			return errors.New("not a classic account address")
+		}
+	case FamilyStellar:
+		version, payload, err := stellarDecode(address)
+		if err != nil {
+			return err
+		}
+		// G addresses have version byte 6 << 3
+		if version != 6<<3 || len(payload) != 32 {
+			return errors.New("not an account public key")
+		}
+	}
+	return nil
+}
+
+// validateAccountIdentifier applies the memo or destination tag rules of the network
+func (n *Network) validateAccountIdentifier(accountIdentifier string) error {
+	if len(accountIdentifier) == 0 {
+		return nil
+	}
+
+	switch n.Family {
+	case FamilyBitcoin, FamilyLitecoin, FamilyEvm:
+		return errors.New("network does not support an account identifier")
+	case FamilyXrp:
+		if _, err := strconv.ParseUint(accountIdentifier, 10, 32); err != nil {
+			return fmt.Errorf("destination tag %q is not an unsigned 32-bit integer", accountIdentifier)
+		}
+	case FamilyStellar:
+		if len(accountIdentifier) > 28 {
+			return fmt.Errorf("memo is %d bytes, at most 28 are allowed", len(accountIdentifier))
+		}
+	}
+	return nil
+}
+
+// validateUtxoAddress accepts segwit addresses with one of the given prefixes or base58check
+// addresses with one of the given version bytes
+func validateUtxoAddress(address string, prefixes []string, versions []byte) error {
+	lower := strings.ToLower(address)
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(lower, prefix+"1") {
+			return validateSegwitAddress(address, prefix)
+		}
+	}
+
+	version, payload, err := base58CheckDecode(address, bitcoinAlphabet)
+	if err != nil {
+		return err
+	}
+	if len(payload) != 20 {
+		return fmt.Errorf("expected a 20 byte hash, got %d", len(payload))
+	}
+	for _, v := range versions {
+		if version == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("unexpected version byte 0x%02x", version)
+}
+
+// validateSegwitAddress applies BIP-173 and BIP-350: witness version 0 uses bech32 and later
+// versions use bech32m
+func validateSegwitAddress(address, prefix string) error {
+	hrp, data, constant, err := bech32Decode(address)
+	if err != nil {
+		return err
+	}
+	if hrp != prefix {
+		return fmt.Errorf("unexpected prefix %q", hrp)
+	}
+	if len(data) == 0 {
+		return errors.New("missing witness version")
+	}
+
+	version := data[0]
+	if version > 16 {
+		return fmt.Errorf("invalid witness version %d", version)
+	}
+	if version == 0 && constant != bech32Const {
+		return errors.New("witness version 0 must use bech32")
+	}
+	if version > 0 && constant != bech32mConst {
+		return fmt.Errorf("witness version %d must use bech32m", version)
+	}
+
+	program, err := convertBits(data[1:], 5, 8)
+	if err != nil {
+		return err
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return fmt.Errorf("invalid witness program length %d", len(program))
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return fmt.Errorf("invalid witness version 0 program length %d", len(program))
+	}
+	return nil
+}
+
+// validateEvmAddress accepts 0x prefixed 20 byte hex addresses. Mixed case addresses must match
+// their EIP-55 checksum; all lower or upper case addresses carry no checksum.
+func validateEvmAddress(address string) error {
+	if len(address) != 42 || (address[:2] != "0x" && address[:2] != "0X") {
+		return errors.New("expected 0x followed by 40 hex characters")
+	}
+	digits := address[2:]
+	if _, err := hex.DecodeString(digits); err != nil {
+		return errors.New("expected 0x followed by 40 hex characters")
+	}
+
+	if digits == strings.ToLower(digits) || digits == strings.ToUpper(digits) {
+		return nil
+	}
+	if digits != eip55(digits)[2:] {
+		return errors.New("invalid EIP-55 checksum")
+	}
+	return nil
+}
+
+// eip55 returns the checksummed form of a 40 character hex address
+func eip55(digits string) string {
+	lower := strings.ToLower(digits)
+	hash := keccak256([]byte(lower))
+
+	out := []byte("0x" + lower)
+	for i := 0; i < len(lower); i++ {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if nibble >= 8 && lower[i] >= 'a' {
+			out[i+2] = lower[i] - 'a' + 'A'
+		}
+	}
+	return string(out)
+}
@@ -0,0 +1,134 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+func TestKeccak256(t *testing.T) {
+	cases := map[string]string{
+		"":    "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		"abc": "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45",
+	}
+	for input, want := range cases {
+		sum := keccak256([]byte(input))
+		if got := hex.EncodeToString(sum[:]); got != want {
+			t.Errorf("keccak256(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	cases := []struct {
+		description       string
+		network           *model.NetworkDetails
+		symbol            string
+		address           string
+		accountIdentifier string
+		valid             bool
+	}{
+		{description: "bitcoin p2pkh", symbol: "BTC", address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", valid: true},
+		{description: "bitcoin p2sh", symbol: "BTC", address: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", valid: true},
+		{description: "bitcoin base58 checksum", symbol: "BTC", address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3"},
+		{description: "bitcoin segwit v0", symbol: "BTC", address: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", valid: true},
+		{description: "bitcoin segwit upper case", symbol: "BTC", address: "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", valid: true},
+		{description: "bitcoin bech32 checksum", symbol: "BTC", address: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5"},
+		{description: "bitcoin taproot", symbol: "BTC", address: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", valid: true},
+		{description: "bitcoin taproot with bech32", symbol: "BTC", address: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd"},
+		{description: "bitcoin testnet address on mainnet", symbol: "BTC", address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"},
+		{
+			description: "bitcoin testnet",
+			network:     &model.NetworkDetails{Id: "bitcoin", Type: "testnet"},
+			address:     "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+			valid:       true,
+		},
+		{description: "bitcoin memo", symbol: "BTC", address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", accountIdentifier: "1"},
+		{description: "ethereum checksum", symbol: "ETH", address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", valid: true},
+		{description: "ethereum checksum all caps", symbol: "ETH", address: "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb", valid: true},
+		{description: "ethereum lower case", symbol: "ETH", address: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", valid: true},
+		{description: "ethereum bad checksum", symbol: "ETH", address: "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{description: "ethereum short", symbol: "ETH", address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA"},
+		{
+			description: "token on base by network id",
+			network:     &model.NetworkDetails{Id: "base-mainnet"},
+			symbol:      "USDC",
+			address:     "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+			valid:       true,
+		},
+		{
+			description: "token on base with bad checksum",
+			network:     &model.NetworkDetails{Id: "base", Type: "mainnet"},
+			symbol:      "USDC",
+			address:     "0xfb6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		},
+		{description: "token without network is not validated", symbol: "USDC", address: "anything", valid: true},
+		{description: "solana", symbol: "SOL", address: "So11111111111111111111111111111111111111112", valid: true},
+		{description: "solana system program", symbol: "SOL", address: "11111111111111111111111111111111", valid: true},
+		{description: "solana short", symbol: "SOL", address: "So1111111111111111111111111111"},
+		{description: "solana invalid character", symbol: "SOL", address: "So11111111111111111111111111111111111111110"},
+		{description: "xrp", symbol: "XRP", address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", accountIdentifier: "4294967295", valid: true},
+		{description: "xrp tag overflow", symbol: "XRP", address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", accountIdentifier: "4294967296"},
+		{description: "xrp tag not numeric", symbol: "XRP", address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", accountIdentifier: "memo"},
+		{description: "xrp checksum", symbol: "XRP", address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTi"},
+		{
+			description:       "stellar memo",
+			symbol:            "XLM",
+			address:           "GAAZI4TCR3TY5OJHCTJC2A4QSY6CJWJH5IAJTGKIN2ER7LBNVKOCCWN7",
+			accountIdentifier: "invoice 42",
+			valid:             true,
+		},
+		{
+			description:       "stellar memo too long",
+			symbol:            "XLM",
+			address:           "GAAZI4TCR3TY5OJHCTJC2A4QSY6CJWJH5IAJTGKIN2ER7LBNVKOCCWN7",
+			accountIdentifier: "a memo that is far too long for stellar",
+		},
+		{description: "stellar checksum", symbol: "XLM", address: "GAAZI4TCR3TY5OJHCTJC2A4QSY6CJWJH5IAJTGKIN2ER7LBNVKOCCWN6"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			err := ValidateAddress(tt.network, tt.symbol, tt.address, tt.accountIdentifier)
+			if tt.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.valid {
+				var addressErr *AddressError
+				if !errors.As(err, &addressErr) {
+					t.Fatalf("expected an address error, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateOnchainAddress(t *testing.T) {
+	if err := ValidateOnchainAddress(model.OnchainNetworkTypeEvm, "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := ValidateOnchainAddress(model.OnchainNetworkTypeSolana, "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"); err == nil {
+		t.Error("expected an evm address to be rejected on solana")
+	}
+	if err := ValidateOnchainAddress(model.OnchainNetworkTypeUnspecified, "anything"); err != nil {
+		t.Errorf("expected unspecified networks to be skipped, got %v", err)
+	}
+}
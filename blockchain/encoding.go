@@ -0,0 +1,269 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"math/big"
+	"math/bits"
+	"strings"
+)
+
+const (
+	bitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	rippleAlphabet  = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+	bech32Charset   = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+// base58Decode decodes s with the given alphabet, keeping leading zero bytes
+func base58Decode(s, alphabet string) ([]byte, error) {
+	if len(s) == 0 {
+		return nil, errors.New("empty base58 string")
+	}
+
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range s {
+		i := strings.IndexRune(alphabet, c)
+		if i < 0 {
+			return nil, errors.New("invalid base58 character")
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(i)))
+	}
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+// base58CheckDecode decodes s and verifies its four byte double SHA-256 checksum, returning the version
+// byte and payload
+func base58CheckDecode(s, alphabet string) (byte, []byte, error) {
+	b, err := base58Decode(s, alphabet)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(b) < 5 {
+		return 0, nil, errors.New("base58check string too short")
+	}
+
+	data, checksum := b[:len(b)-4], b[len(b)-4:]
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:4], checksum) {
+		return 0, nil, errors.New("invalid base58check checksum")
+	}
+	return data[0], data[1:], nil
+}
+
+// bech32 checksum constants of BIP-173 and BIP-350
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// bech32Decode decodes a bech32 or bech32m string, returning the human readable part, the 5-bit data
+// without the checksum and the checksum constant it verified against
+func bech32Decode(s string) (string, []byte, uint32, error) {
+	if len(s) > 90 {
+		return "", nil, 0, errors.New("bech32 string too long")
+	}
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, 0, errors.New("mixed case bech32 string")
+	}
+	s = strings.ToLower(s)
+
+	sep := strings.LastIndexByte(s, '1')
+	if sep < 1 || sep+7 > len(s) {
+		return "", nil, 0, errors.New("invalid bech32 separator position")
+	}
+	hrp := s[:sep]
+
+	values := make([]byte, 0, len(hrp)*2+1+len(s)-sep-1)
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, 0, errors.New("invalid bech32 human readable part")
+		}
+		values = append(values, hrp[i]>>5)
+	}
+	values = append(values, 0)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]&31)
+	}
+
+	data := make([]byte, 0, len(s)-sep-1)
+	for _, c := range s[sep+1:] {
+		i := strings.IndexRune(bech32Charset, c)
+		if i < 0 {
+			return "", nil, 0, errors.New("invalid bech32 character")
+		}
+		data = append(data, byte(i))
+	}
+
+	switch constant := bech32Polymod(append(values, data...)); constant {
+	case bech32Const, bech32mConst:
+		return hrp, data[:len(data)-6], constant, nil
+	}
+	return "", nil, 0, errors.New("invalid bech32 checksum")
+}
+
+// convertBits regroups 5-bit groups into bytes, rejecting non-zero or excess padding
+func convertBits(data []byte, from, to uint) ([]byte, error) {
+	var (
+		acc  uint32
+		nbit uint
+		out  []byte
+		max  = uint32(1)<<to - 1
+	)
+	for _, v := range data {
+		acc = acc<<from | uint32(v)
+		nbit += from
+		for nbit >= to {
+			nbit -= to
+			out = append(out, byte(acc>>nbit&max))
+		}
+	}
+	if nbit >= from || (acc<<(to-nbit))&max != 0 {
+		return nil, errors.New("invalid bech32 padding")
+	}
+	return out, nil
+}
+
+// stellarDecode decodes a Stellar StrKey, verifying its CRC16-XModem checksum, and returns the version
+// byte and payload
+func stellarDecode(s string) (byte, []byte, error) {
+	b, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s)
+	if err != nil {
+		return 0, nil, errors.New("invalid base32 string")
+	}
+	if len(b) < 3 {
+		return 0, nil, errors.New("strkey too short")
+	}
+
+	data, checksum := b[:len(b)-2], b[len(b)-2:]
+	crc := uint16(0)
+	for _, c := range data {
+		crc ^= uint16(c) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	if byte(crc) != checksum[0] || byte(crc>>8) != checksum[1] {
+		return 0, nil, errors.New("invalid strkey checksum")
+	}
+	return data[0], data[1:], nil
+}
+
+var keccakRoundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808a, 0x8000000080008000,
+	0x000000000000808b, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008a, 0x0000000000000088, 0x0000000080008009, 0x000000008000000a,
+	0x000000008000808b, 0x800000000000008b, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800a, 0x800000008000000a,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+var keccakRotations = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+func keccakF1600(a *[25]uint64) {
+	var b [25]uint64
+	var c, d [5]uint64
+	for round := 0; round < 24; round++ {
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+		}
+		for i := 0; i < 25; i++ {
+			a[i] ^= d[i%5]
+		}
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft64(a[x+5*y], keccakRotations[x+5*y])
+			}
+		}
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				a[x+5*y] = b[x+5*y] ^ (^b[(x+1)%5+5*y] & b[(x+2)%5+5*y])
+			}
+		}
+		a[0] ^= keccakRoundConstants[round]
+	}
+}
+
+// keccak256 is the original Keccak-256 used by Ethereum, which pads differently from SHA3-256
+func keccak256(data []byte) [32]byte {
+	const rate = 136
+	var state [25]uint64
+
+	padded := append(append([]byte{}, data...), 0x01)
+	for len(padded)%rate != 0 {
+		padded = append(padded, 0)
+	}
+	padded[len(padded)-1] |= 0x80
+
+	for off := 0; off < len(padded); off += rate {
+		for i := 0; i < rate/8; i++ {
+			var lane uint64
+			for j := 0; j < 8; j++ {
+				lane |= uint64(padded[off+i*8+j]) << (8 * j)
+			}
+			state[i] ^= lane
+		}
+		keccakF1600(&state)
+	}
+
+	var out [32]byte
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 8; j++ {
+			out[i*8+j] = byte(state[i] >> (8 * j))
+		}
+	}
+	return out
+}
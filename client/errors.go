@@ -0,0 +1,39 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/coinbase-samples/core-go"
+)
+
+// HttpStatusCode returns the HTTP status code carried by an API error, or 0 when err did not
+// come from an HTTP response (e.g. a network failure or timeout).
+func HttpStatusCode(err error) int {
+	var apiErr *core.ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr.CodeReceived
+	}
+	return 0
+}
+
+// IsRateLimited returns true if err is an HTTP 429 response
+func IsRateLimited(err error) bool {
+	return HttpStatusCode(err) == http.StatusTooManyRequests
+}
@@ -0,0 +1,51 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/coinbase-samples/core-go"
+)
+
+func TestHttpStatusCode(t *testing.T) {
+	cases := []struct {
+		description string
+		err         error
+		code        int
+		limited     bool
+	}{
+		{"Nil", nil, 0, false},
+		{"Plain", errors.New("dial tcp: timeout"), 0, false},
+		{"ApiError", &core.ApiError{CodeReceived: 404}, 404, false},
+		{"RateLimited", &core.ApiError{CodeReceived: 429}, 429, true},
+		{"Wrapped", fmt.Errorf("cancel: %w", &core.ApiError{CodeReceived: 429}), 429, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := HttpStatusCode(tc.err); got != tc.code {
+				t.Errorf("HttpStatusCode() = %d; want %d", got, tc.code)
+			}
+			if got := IsRateLimited(tc.err); got != tc.limited {
+				t.Errorf("IsRateLimited() = %v; want %v", got, tc.limited)
+			}
+		})
+	}
+}
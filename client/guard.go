@@ -0,0 +1,305 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KillSwitchStatusCode is the HTTP status reported for calls blocked by an engaged kill switch.
+// It is a 4xx so that blocked calls are treated as definitively rejected, never as ambiguous.
+const KillSwitchStatusCode = http.StatusLocked
+
+const killSwitchSource = "file"
+
+// DryRunCall describes a mutating call intercepted in dry-run mode
+type DryRunCall struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// Guard blocks (kill switch) or simulates (dry run) every state-changing call made through an
+// http.Client whose transport it wraps. Both modes can be flipped at runtime, from a file with
+// WatchFile, or over HTTP with Handler. Calls are mutating unless they are GET, HEAD or OPTIONS
+// requests or their path ends with one of ReadOnlyPathSuffixes. Calls whose path ends with one of
+// HaltExemptPathSuffixes, cancels by default, still go through while halted so positions can be
+// flattened during an incident.
+//
+//	guard := client.NewGuard()
+//	httpClient, _ := client.DefaultHttpClient()
+//	httpClient.Transport = guard.Transport(httpClient.Transport)
+//	restClient := client.NewRestClient(creds, httpClient)
+type Guard struct {
+	// ReadOnlyPathSuffixes lists POST endpoints that do not change state, e.g. previews
+	ReadOnlyPathSuffixes []string
+	// HaltExemptPathSuffixes lists mutating endpoints the kill switch lets through, e.g. cancels.
+	// They are still simulated in dry-run mode.
+	HaltExemptPathSuffixes []string
+	// Logger logs every blocked and dry-run call (default slog.Default())
+	Logger *slog.Logger
+	// DryRunResponse returns the synthetic JSON body for a dry-run call (default "{}")
+	DryRunResponse func(call DryRunCall) []byte
+	// OnDryRun is called for every call intercepted in dry-run mode
+	OnDryRun func(call DryRunCall)
+	// OnBlocked is called for every call blocked by the kill switch
+	OnBlocked func(method, path, reason string)
+
+	mu     sync.RWMutex
+	halted bool
+	reason string
+	source string
+	dryRun bool
+}
+
+// GuardState is the state reported and accepted by Guard.Handler
+type GuardState struct {
+	Halted bool   `json:"halted"`
+	Reason string `json:"reason,omitempty"`
+	DryRun bool   `json:"dry_run"`
+}
+
+// NewGuard creates a guard with the kill switch released and dry run disabled
+func NewGuard() *Guard {
+	return &Guard{
+		ReadOnlyPathSuffixes: []string{
+			"/order_preview",
+			"/unstake/preview",
+			"/transaction-validators/query",
+		},
+		HaltExemptPathSuffixes: []string{
+			"/cancel",
+		},
+		Logger: slog.Default(),
+	}
+}
+
+// Halt engages the kill switch. Mutating calls fail until Resume is called.
+func (g *Guard) Halt(reason string) {
+	g.set(true, reason, "")
+}
+
+// Resume releases the kill switch
+func (g *Guard) Resume() {
+	g.set(false, "", "")
+}
+
+// SetDryRun enables or disables dry-run mode
+func (g *Guard) SetDryRun(enabled bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.dryRun = enabled
+}
+
+// State returns the current kill switch and dry-run state
+func (g *Guard) State() GuardState {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return GuardState{Halted: g.halted, Reason: g.reason, DryRun: g.dryRun}
+}
+
+func (g *Guard) set(halted bool, reason, source string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.halted = halted
+	g.reason = reason
+	g.source = source
+}
+
+// WatchFile polls path every interval and engages the kill switch while the file exists, using
+// its contents as the reason. Removing the file releases a halt engaged by the file, but not one
+// engaged through Halt. It blocks until ctx is done.
+func (g *Guard) WatchFile(ctx context.Context, path string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		b, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			reason := strings.TrimSpace(string(b))
+			if len(reason) == 0 {
+				reason = fmt.Sprintf("kill file %s present", path)
+			}
+			g.mu.Lock()
+			if !g.halted || g.source == killSwitchSource {
+				g.halted, g.reason, g.source = true, reason, killSwitchSource
+			}
+			g.mu.Unlock()
+		case errors.Is(err, os.ErrNotExist):
+			g.mu.Lock()
+			if g.halted && g.source == killSwitchSource {
+				g.halted, g.reason, g.source = false, "", ""
+			}
+			g.mu.Unlock()
+		default:
+			return fmt.Errorf("unable to read kill file %s: %w", path, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Handler returns an http.Handler that reports the GuardState on GET and replaces it on POST or PUT
+// with a GuardState JSON body. Protect it as you would any trading control.
+func (g *Guard) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost, http.MethodPut:
+			var state GuardState
+			if err := json.NewDecoder(r.Body).Decode(&state); err != nil {
+				http.Error(w, fmt.Sprintf("invalid guard state: %v", err), http.StatusBadRequest)
+				return
+			}
+			if state.Halted {
+				g.Halt(state.Reason)
+			} else {
+				g.Resume()
+			}
+			g.SetDryRun(state.DryRun)
+		default:
+			w.Header().Set("Allow", "GET, POST, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(g.State())
+	})
+}
+
+// Transport wraps next (http.DefaultTransport when nil) with the guard
+func (g *Guard) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &guardTransport{guard: g, next: next}
+}
+
+// IsMutating returns true if the call would change state at Prime
+func (g *Guard) IsMutating(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	for _, suffix := range g.ReadOnlyPathSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsHaltExempt returns true if the call goes through while the kill switch is engaged
+func (g *Guard) IsHaltExempt(path string) bool {
+	for _, suffix := range g.HaltExemptPathSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Guard) logger() *slog.Logger {
+	if g.Logger == nil {
+		return slog.Default()
+	}
+	return g.Logger
+}
+
+// IsKillSwitchEngaged returns true if err is a call blocked by a Guard
+func IsKillSwitchEngaged(err error) bool {
+	return HttpStatusCode(err) == KillSwitchStatusCode
+}
+
+type guardTransport struct {
+	guard *Guard
+	next  http.RoundTripper
+}
+
+func (t *guardTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	g := t.guard
+	if !g.IsMutating(req.Method, req.URL.Path) {
+		return t.next.RoundTrip(req)
+	}
+
+	state := g.State()
+
+	if state.Halted && !g.IsHaltExempt(req.URL.Path) {
+		g.logger().Warn("kill switch blocked call", "method", req.Method, "path", req.URL.Path, "reason", state.Reason)
+		if g.OnBlocked != nil {
+			g.OnBlocked(req.Method, req.URL.Path, state.Reason)
+		}
+		body, _ := json.Marshal(map[string]string{"message": "kill switch engaged: " + state.Reason})
+		return syntheticResponse(req, KillSwitchStatusCode, body), nil
+	}
+
+	if !state.DryRun {
+		return t.next.RoundTrip(req)
+	}
+
+	call := DryRunCall{Method: req.Method, Path: req.URL.Path}
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		call.Body = b
+	}
+
+	g.logger().Info("dry run intercepted call", "method", call.Method, "path", call.Path, "body", string(call.Body))
+	if g.OnDryRun != nil {
+		g.OnDryRun(call)
+	}
+
+	body := []byte("{}")
+	if g.DryRunResponse != nil {
+		body = g.DryRunResponse(call)
+	}
+	return syntheticResponse(req, http.StatusOK, body), nil
+}
+
+func syntheticResponse(req *http.Request, code int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
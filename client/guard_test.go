@@ -0,0 +1,170 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/core-go"
+	"github.com/coinbase-samples/prime-sdk-go/credentials"
+)
+
+func newGuardedClient(t *testing.T, guard *Guard) (RestClient, *int32) {
+	t.Helper()
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Write([]byte(`{"order_id":"live"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	httpClient := http.Client{Transport: guard.Transport(nil)}
+	c := NewRestClient(&credentials.Credentials{SigningKey: "key"}, httpClient).SetBaseUrl(server.URL)
+	return c, &hits
+}
+
+type orderIdResponse struct {
+	OrderId string `json:"order_id"`
+}
+
+func post(c RestClient, path string) (*orderIdResponse, error) {
+	resp := &orderIdResponse{}
+	err := core.HttpPost(context.Background(), c, path, core.EmptyQueryParams, DefaultSuccessHttpStatusCodes, map[string]string{"a": "b"}, resp, c.HeadersFunc())
+	return resp, err
+}
+
+func get(c RestClient, path string) error {
+	return core.HttpGet(context.Background(), c, path, core.EmptyQueryParams, DefaultSuccessHttpStatusCodes, nil, &orderIdResponse{}, c.HeadersFunc())
+}
+
+func TestGuardKillSwitch(t *testing.T) {
+	guard := NewGuard()
+	var blocked []string
+	guard.OnBlocked = func(method, path, reason string) { blocked = append(blocked, path) }
+	c, hits := newGuardedClient(t, guard)
+
+	guard.Halt("runaway algo")
+
+	_, err := post(c, "/portfolios/p1/order")
+	if !IsKillSwitchEngaged(err) || !strings.Contains(err.Error(), "runaway algo") {
+		t.Fatalf("expected kill switch error, got %v", err)
+	}
+	if code := HttpStatusCode(err); code == 0 || code >= http.StatusInternalServerError {
+		t.Errorf("kill switch status %d must not look ambiguous", code)
+	}
+	if err := get(c, "/portfolios/p1/orders"); err != nil {
+		t.Fatalf("reads must pass while halted: %v", err)
+	}
+	if _, err := post(c, "/portfolios/p1/order_preview"); err != nil {
+		t.Fatalf("previews must pass while halted: %v", err)
+	}
+	if _, err := post(c, "/portfolios/p1/orders/o1/cancel"); err != nil {
+		t.Fatalf("cancels must pass while halted: %v", err)
+	}
+	if *hits != 3 || len(blocked) != 1 {
+		t.Errorf("expected 3 hits and 1 block, got %d and %v", *hits, blocked)
+	}
+
+	guard.Resume()
+	if resp, err := post(c, "/portfolios/p1/order"); err != nil || resp.OrderId != "live" {
+		t.Fatalf("expected live call after resume, got %+v, %v", resp, err)
+	}
+}
+
+func TestGuardDryRun(t *testing.T) {
+	var logged bytes.Buffer
+	guard := NewGuard()
+	guard.Logger = slog.New(slog.NewTextHandler(&logged, nil))
+	var calls []DryRunCall
+	guard.OnDryRun = func(call DryRunCall) { calls = append(calls, call) }
+	guard.DryRunResponse = func(call DryRunCall) []byte { return []byte(`{"order_id":"dry"}`) }
+	c, hits := newGuardedClient(t, guard)
+
+	guard.SetDryRun(true)
+
+	resp, err := post(c, "/portfolios/p1/order")
+	if err != nil || resp.OrderId != "dry" {
+		t.Fatalf("expected synthetic response, got %+v, %v", resp, err)
+	}
+	if *hits != 0 || len(calls) != 1 || string(calls[0].Body) != `{"a":"b"}` {
+		t.Errorf("unexpected dry run calls: %d hits, %+v", *hits, calls)
+	}
+	if !strings.Contains(logged.String(), "path=/portfolios/p1/order") {
+		t.Errorf("expected the dry run call to be logged, got %q", logged.String())
+	}
+}
+
+func TestGuardWatchFile(t *testing.T) {
+	guard := NewGuard()
+	path := filepath.Join(t.TempDir(), "halt")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go guard.WatchFile(ctx, path, time.Millisecond)
+
+	waitFor := func(halted bool) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for guard.State().Halted != halted {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for halted=%v", halted)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte("maintenance\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(true)
+	if got := guard.State().Reason; got != "maintenance" {
+		t.Errorf("unexpected reason %q", got)
+	}
+
+	os.Remove(path)
+	waitFor(false)
+}
+
+func TestGuardHandler(t *testing.T) {
+	guard := NewGuard()
+	handler := guard.Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"halted":true,"reason":"ops","dry_run":true}`)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d", rec.Code)
+	}
+	if state := guard.State(); !state.Halted || state.Reason != "ops" || !state.DryRun {
+		t.Errorf("unexpected state: %+v", state)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{invalid`)))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected bad request, got %d", rec.Code)
+	}
+}
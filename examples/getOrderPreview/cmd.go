@@ -48,7 +48,7 @@ func main() {
 	}
 	productId := os.Args[1]
 	side := os.Args[2]
-	orderType := os.Args[3]
+	orderType := model.OrderType(os.Args[3])
 	baseQuantity := os.Args[4]
 	limitPrice := os.Args[5]
 
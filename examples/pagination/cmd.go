@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/coinbase-samples/prime-sdk-go/client"
 	"github.com/coinbase-samples/prime-sdk-go/credentials"
@@ -59,6 +60,9 @@ func main() {
 
 	// Example 6: Override pagination config on response iterator
 	overrideConfigOnIterator(ctx, restClient, credentials.PortfolioId)
+
+	// Example 7: Stream wallet transactions to a pool of workers
+	streamToWorkers(ctx, restClient, credentials.PortfolioId)
 }
 
 // fetchSecondPage demonstrates manually fetching the second page of results
@@ -384,3 +388,53 @@ func overrideConfigOnIterator(ctx context.Context, restClient client.RestClient,
 	overriddenWallets, _ := resp5.Iterator().WithConfig(overrideConfig).FetchAll(ctx)
 	fmt.Printf("Using overridden config (max 5 pages): %d wallets\n", len(overriddenWallets))
 }
+
+// streamToWorkers demonstrates piping paginated results into concurrent workers over a channel.
+// The producer only fetches the next page once the workers have drained the buffer.
+func streamToWorkers(ctx context.Context, restClient client.RestClient, portfolioId string) {
+	fmt.Println("\n=== Example 7: Stream Wallet Transactions to Workers ===")
+
+	walletsSvc := wallets.NewWalletsService(restClient)
+
+	walletsResp, err := walletsSvc.ListWallets(ctx, &wallets.ListWalletsRequest{
+		PortfolioId: portfolioId,
+		Type:        "TRADING",
+	})
+	if err != nil {
+		log.Fatalf("error fetching wallets: %v", err)
+	}
+
+	if len(walletsResp.Wallets) == 0 {
+		fmt.Println("No wallets available")
+		return
+	}
+
+	txnSvc := transactions.NewTransactionsService(restClient)
+
+	resp, err := txnSvc.ListWalletTransactions(ctx, &transactions.ListWalletTransactionsRequest{
+		PortfolioId: portfolioId,
+		WalletId:    walletsResp.Wallets[0].Id,
+	})
+	if err != nil {
+		log.Fatalf("error fetching wallet transactions: %v", err)
+	}
+
+	items, errs := resp.Iterator().Stream(ctx, 50)
+
+	var wg sync.WaitGroup
+	for w := 1; w <= 4; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for item := range items {
+				fmt.Printf("Worker %d: page %d transaction %s\n", worker, item.Page.Index, item.Item.Id)
+			}
+		}(w)
+	}
+
+	wg.Wait()
+
+	if err := <-errs; err != nil {
+		log.Fatalf("error streaming wallet transactions: %v", err)
+	}
+}
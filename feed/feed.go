@@ -0,0 +1,326 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package feed polls orders, transactions and activities and turns them into a single stream of
+// created and updated events. Progress is kept as a high-water mark and the last delivered status of
+// every recent item, persisted through a pluggable Store, and delivery is at least once.
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/activities"
+	"github.com/coinbase-samples/prime-sdk-go/client"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/coinbase-samples/prime-sdk-go/transactions"
+)
+
+// Kind identifies what an Event carries
+type Kind string
+
+const (
+	KindOrder       Kind = "ORDER"
+	KindTransaction Kind = "TRANSACTION"
+	KindActivity    Kind = "ACTIVITY"
+)
+
+// EventType is whether an item is new to the feed or has changed since it was last delivered
+type EventType string
+
+const (
+	EventCreated EventType = "CREATED"
+	EventUpdated EventType = "UPDATED"
+)
+
+// Event reports a new or changed item. Exactly one of Order, Transaction or Activity is set, matching Kind.
+type Event struct {
+	Kind Kind
+	Type EventType
+	Id   string
+	// Status is the item status; PreviousStatus is the status last delivered for an update
+	Status         string
+	PreviousStatus string
+	Order          *model.Order
+	Transaction    *model.Transaction
+	Activity       *model.Activity
+}
+
+// Handler consumes an event. An error stops the poll and the event is redelivered by the next poll,
+// as is any event delivered after the last successful save, so handlers must be idempotent.
+// Handlers are never called concurrently.
+type Handler func(ctx context.Context, event Event) error
+
+// Config configures a Feed
+type Config struct {
+	// PortfolioId is the portfolio polled (required)
+	PortfolioId string
+	// Handler receives every event (required)
+	Handler Handler
+	// Store persists high-water marks and delivered statuses (default in-memory)
+	Store Store
+	// OrdersInterval, TransactionsInterval and ActivitiesInterval are how often Run polls each
+	// stream (default 10s)
+	OrdersInterval       time.Duration
+	TransactionsInterval time.Duration
+	ActivitiesInterval   time.Duration
+	// Lookback bounds the first poll of a stream without a stored state (default 24h)
+	Lookback time.Duration
+	// Overlap is how far before the high-water mark each poll starts, to catch items that become
+	// visible late (default 5m)
+	Overlap time.Duration
+	// MaxGetFailures is how many polls in a row a tracked item that is no longer listed may fail
+	// to be fetched before it is no longer tracked (default 5). Items that are not found are
+	// dropped immediately.
+	MaxGetFailures int
+	// OnError receives the errors of polls made by Run
+	OnError func(kind Kind, err error)
+}
+
+// Feed polls the configured streams. A nil service passed to New disables its stream.
+type Feed struct {
+	config  Config
+	streams map[Kind]*stream
+	mu      sync.Mutex
+	now     func() time.Time
+}
+
+// New creates a Feed. Orders are polled from ListOpenOrders and ListOrders, transactions from
+// ListPortfolioTransactions and activities from ListActivities.
+func New(
+	ordersService orders.OrdersService,
+	transactionsService transactions.TransactionsService,
+	activitiesService activities.ActivitiesService,
+	config *Config,
+) (*Feed, error) {
+	if config == nil || len(config.PortfolioId) == 0 {
+		return nil, errors.New("feed portfolio id is required")
+	}
+	if config.Handler == nil {
+		return nil, errors.New("feed handler is required")
+	}
+
+	cfg := *config
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryStore()
+	}
+	for _, interval := range []*time.Duration{&cfg.OrdersInterval, &cfg.TransactionsInterval, &cfg.ActivitiesInterval} {
+		if *interval <= 0 {
+			*interval = 10 * time.Second
+		}
+	}
+	if cfg.Lookback <= 0 {
+		cfg.Lookback = 24 * time.Hour
+	}
+	if cfg.Overlap <= 0 {
+		cfg.Overlap = 5 * time.Minute
+	}
+	if cfg.MaxGetFailures <= 0 {
+		cfg.MaxGetFailures = 5
+	}
+
+	f := &Feed{config: cfg, streams: make(map[Kind]*stream), now: time.Now}
+	if ordersService != nil {
+		f.streams[KindOrder] = orderStream(ordersService, cfg.PortfolioId, cfg.OrdersInterval)
+	}
+	if transactionsService != nil {
+		f.streams[KindTransaction] = transactionStream(transactionsService, cfg.PortfolioId, cfg.TransactionsInterval)
+	}
+	if activitiesService != nil {
+		f.streams[KindActivity] = activityStream(activitiesService, cfg.PortfolioId, cfg.ActivitiesInterval)
+	}
+	if len(f.streams) == 0 {
+		return nil, errors.New("feed requires at least one service")
+	}
+
+	return f, nil
+}
+
+// errNotFound is returned by stream gets when the service returned no item
+var errNotFound = errors.New("not found")
+
+// item is one listed order, transaction or activity
+type item struct {
+	id      string
+	status  string
+	version string
+	created time.Time
+	// terminal items are forgotten once they fall behind the polling window
+	terminal bool
+	event    Event
+}
+
+// stream lists the items of one kind created in a window, and gets single items that are still
+// being tracked but were not listed
+type stream struct {
+	kind     Kind
+	interval time.Duration
+	list     func(ctx context.Context, start, end time.Time) ([]*item, error)
+	get      func(ctx context.Context, id string) (*item, error)
+}
+
+// Poll polls one stream once and delivers its events in creation order. The state is saved after
+// every poll, including one stopped by a handler error, so only undelivered events are repeated.
+// Tracked items that fail to be fetched are skipped and their errors returned after the poll
+// completes; they stop being tracked once not found or after MaxGetFailures failures in a row.
+func (f *Feed) Poll(ctx context.Context, kind Kind) error {
+	s, ok := f.streams[kind]
+	if !ok {
+		return fmt.Errorf("feed stream %s is not configured", kind)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := f.config.PortfolioId + "/" + string(kind)
+	state, err := f.config.Store.Load(ctx, key)
+	if err != nil {
+		return fmt.Errorf("unable to load feed state %s: %w", key, err)
+	}
+	if state == nil {
+		state = &State{Key: key}
+	}
+	if state.Seen == nil {
+		state.Seen = make(map[string]*Seen)
+	}
+
+	now := f.now()
+	start := now.Add(-f.config.Lookback)
+	if !state.HighWaterMark.IsZero() {
+		start = state.HighWaterMark.Add(-f.config.Overlap)
+	}
+
+	items, err := s.list(ctx, start, now)
+	if err != nil {
+		return fmt.Errorf("unable to list %s: %w", kind, err)
+	}
+
+	// Items still in flight but created before the window are fetched individually
+	listed := make(map[string]bool, len(items))
+	for _, it := range items {
+		listed[it.id] = true
+	}
+	var getErrs []error
+	for id, seen := range state.Seen {
+		if seen.Terminal || listed[id] {
+			continue
+		}
+		it, err := s.get(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			seen.Failures++
+			if errors.Is(err, errNotFound) || client.HttpStatusCode(err) == http.StatusNotFound || seen.Failures >= f.config.MaxGetFailures {
+				delete(state.Seen, id)
+				getErrs = append(getErrs, fmt.Errorf("stopped tracking %s %s: %w", kind, id, err))
+			} else {
+				getErrs = append(getErrs, fmt.Errorf("unable to get %s %s: %w", kind, id, err))
+			}
+			continue
+		}
+		seen.Failures = 0
+		items = append(items, it)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if !items[i].created.Equal(items[j].created) {
+			return items[i].created.Before(items[j].created)
+		}
+		return items[i].id < items[j].id
+	})
+
+	highWaterMark := state.HighWaterMark
+	for _, it := range items {
+		seen, ok := state.Seen[it.id]
+		if ok && seen.Version == it.version {
+			continue
+		}
+
+		event := it.event
+		event.Kind, event.Id, event.Status = kind, it.id, it.status
+		event.Type = EventCreated
+		if ok {
+			event.Type, event.PreviousStatus = EventUpdated, seen.Status
+		}
+
+		if err := f.config.Handler(ctx, event); err != nil {
+			if saveErr := f.save(ctx, state); saveErr != nil {
+				return errors.Join(err, saveErr)
+			}
+			return err
+		}
+
+		state.Seen[it.id] = &Seen{Status: it.status, Version: it.version, Created: it.created, Terminal: it.terminal}
+		if it.created.After(highWaterMark) && !it.created.After(now) {
+			highWaterMark = it.created
+		}
+	}
+
+	state.HighWaterMark = highWaterMark
+	cutoff := highWaterMark.Add(-f.config.Overlap)
+	for id, seen := range state.Seen {
+		if seen.Terminal && seen.Created.Before(cutoff) {
+			delete(state.Seen, id)
+		}
+	}
+
+	return errors.Join(append([]error{f.save(ctx, state)}, getErrs...)...)
+}
+
+func (f *Feed) save(ctx context.Context, state *State) error {
+	state.UpdatedAt = f.now().UTC()
+	if err := f.config.Store.Save(ctx, state); err != nil {
+		return fmt.Errorf("unable to save feed state %s: %w", state.Key, err)
+	}
+	return nil
+}
+
+// Run polls every configured stream on its interval until ctx is done. Poll errors are reported
+// to OnError and polling continues.
+func (f *Feed) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for _, s := range f.streams {
+		wg.Add(1)
+		go func(s *stream) {
+			defer wg.Done()
+
+			ticker := time.NewTicker(s.interval)
+			defer ticker.Stop()
+
+			for {
+				if err := f.Poll(ctx, s.kind); err != nil && ctx.Err() == nil && f.config.OnError != nil {
+					f.config.OnError(s.kind, err)
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(s)
+	}
+
+	wg.Wait()
+	return ctx.Err()
+}
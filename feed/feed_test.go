@@ -0,0 +1,302 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/coinbase-samples/prime-sdk-go/transactions"
+)
+
+var base = time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+type fakeTransactions struct {
+	transactions.TransactionsService
+	transactions map[string]*model.Transaction
+	requests     []*transactions.ListPortfolioTransactionsRequest
+}
+
+func (f *fakeTransactions) ListPortfolioTransactions(ctx context.Context, r *transactions.ListPortfolioTransactionsRequest) (*transactions.ListPortfolioTransactionsResponse, error) {
+	f.requests = append(f.requests, r)
+	var found []*model.Transaction
+	for _, t := range f.transactions {
+		if !t.Created.Before(r.Start) && !t.Created.After(r.End) {
+			cp := *t
+			found = append(found, &cp)
+		}
+	}
+	return &transactions.ListPortfolioTransactionsResponse{Transactions: found, Request: r}, nil
+}
+
+func (f *fakeTransactions) GetTransaction(ctx context.Context, r *transactions.GetTransactionRequest) (*transactions.GetTransactionResponse, error) {
+	cp := *f.transactions[r.TransactionId]
+	return &transactions.GetTransactionResponse{Transaction: &cp}, nil
+}
+
+type fakeOrders struct {
+	orders.OrdersService
+	open   []*model.Order
+	recent []*model.Order
+	get    map[string]*model.Order
+	getErr map[string]error
+}
+
+func (f *fakeOrders) ListOpenOrders(ctx context.Context, r *orders.ListOpenOrdersRequest) (*orders.ListOpenOrdersResponse, error) {
+	return &orders.ListOpenOrdersResponse{Orders: f.open}, nil
+}
+
+func (f *fakeOrders) ListOrders(ctx context.Context, r *orders.ListOrdersRequest) (*orders.ListOrdersResponse, error) {
+	return &orders.ListOrdersResponse{Orders: f.recent, Request: r}, nil
+}
+
+func (f *fakeOrders) GetOrder(ctx context.Context, r *orders.GetOrderRequest) (*orders.GetOrderResponse, error) {
+	if err := f.getErr[r.OrderId]; err != nil {
+		return nil, err
+	}
+	return &orders.GetOrderResponse{Order: f.get[r.OrderId]}, nil
+}
+
+type recorder struct {
+	events []Event
+	fail   map[string]bool
+}
+
+func (r *recorder) handle(ctx context.Context, e Event) error {
+	if r.fail[e.Id] {
+		return errors.New("handler failed")
+	}
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *recorder) take() []string {
+	var got []string
+	for _, e := range r.events {
+		got = append(got, string(e.Type)+":"+e.Id+":"+e.Status)
+	}
+	r.events = nil
+	return got
+}
+
+func assertEvents(t *testing.T, expected, got []string) {
+	t.Helper()
+	if len(expected) != len(got) {
+		t.Fatalf("expected events %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if expected[i] != got[i] {
+			t.Fatalf("expected events %v, got %v", expected, got)
+		}
+	}
+}
+
+func TestPollTransactions(t *testing.T) {
+	svc := &fakeTransactions{transactions: map[string]*model.Transaction{
+		"t1": {Id: "t1", Status: model.TransactionStatusDone, Created: model.NewTimestamp(base.Add(-2 * time.Hour))},
+		"t2": {Id: "t2", Status: model.TransactionStatusProcessing, Created: model.NewTimestamp(base.Add(-time.Hour))},
+	}}
+	rec := &recorder{fail: map[string]bool{}}
+	store := NewMemoryStore()
+
+	f, err := New(nil, svc, nil, &Config{PortfolioId: "p1", Handler: rec.handle, Store: store})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := base
+	f.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if err := f.Poll(ctx, KindTransaction); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertEvents(t, []string{"CREATED:t1:TRANSACTION_DONE", "CREATED:t2:TRANSACTION_PROCESSING"}, rec.take())
+	if !svc.requests[0].Start.Equal(base.Add(-24 * time.Hour)) {
+		t.Errorf("expected first poll to look back a day, got %s", svc.requests[0].Start)
+	}
+
+	// Nothing changed, so nothing is redelivered
+	now = base.Add(time.Minute)
+	if err := f.Poll(ctx, KindTransaction); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertEvents(t, nil, rec.take())
+	if start := svc.requests[1].Start; !start.Equal(base.Add(-time.Hour - 5*time.Minute)) {
+		t.Errorf("expected poll from the high-water mark less the overlap, got %s", start)
+	}
+
+	// t2 completes after falling out of the window, t3 is new and its handler fails once
+	now = base.Add(2 * time.Hour)
+	svc.transactions["t2"].Status = model.TransactionStatusDone
+	svc.transactions["t3"] = &model.Transaction{Id: "t3", Status: model.TransactionStatusCreated, Created: model.NewTimestamp(base.Add(90 * time.Minute))}
+	svc.transactions["t4"] = &model.Transaction{Id: "t4", Status: model.TransactionStatusCreated, Created: model.NewTimestamp(base.Add(100 * time.Minute))}
+	rec.fail["t3"] = true
+
+	if err := f.Poll(ctx, KindTransaction); err == nil {
+		t.Fatal("expected handler error")
+	}
+	assertEvents(t, []string{"UPDATED:t2:TRANSACTION_DONE"}, rec.take())
+
+	delete(rec.fail, "t3")
+	if err := f.Poll(ctx, KindTransaction); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertEvents(t, []string{"CREATED:t3:TRANSACTION_CREATED", "CREATED:t4:TRANSACTION_CREATED"}, rec.take())
+
+	state, _ := store.Load(ctx, "p1/TRANSACTION")
+	if !state.HighWaterMark.Equal(base.Add(100 * time.Minute)) {
+		t.Errorf("expected high-water mark at t4, got %s", state.HighWaterMark)
+	}
+	if _, ok := state.Seen["t1"]; ok {
+		t.Error("expected terminal t1 to be pruned")
+	}
+	if _, ok := state.Seen["t3"]; !ok {
+		t.Error("expected pending t3 to be tracked")
+	}
+}
+
+func TestPollOrders(t *testing.T) {
+	created := model.NewTimestamp(base.Add(-48 * time.Hour))
+	svc := &fakeOrders{
+		open: []*model.Order{{Id: "o1", Status: model.OrderStatusOpen, FilledQuantity: "0", Created: created}},
+	}
+	rec := &recorder{}
+
+	f, err := New(svc, nil, nil, &Config{PortfolioId: "p1", Handler: rec.handle})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f.now = func() time.Time { return base }
+	ctx := context.Background()
+
+	if err := f.Poll(ctx, KindOrder); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertEvents(t, []string{"CREATED:o1:OPEN"}, rec.take())
+
+	// A partial fill keeps the status but is still an update
+	svc.open = []*model.Order{{Id: "o1", Status: model.OrderStatusOpen, FilledQuantity: "1", Created: created}}
+	if err := f.Poll(ctx, KindOrder); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertEvents(t, []string{"UPDATED:o1:OPEN"}, rec.take())
+
+	// The order leaves the open list and is older than the window, so it is fetched
+	svc.open = nil
+	svc.get = map[string]*model.Order{"o1": {Id: "o1", Status: model.OrderStatusFilled, FilledQuantity: "2", Created: created}}
+	if err := f.Poll(ctx, KindOrder); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	events := rec.events
+	assertEvents(t, []string{"UPDATED:o1:FILLED"}, rec.take())
+	if events[0].PreviousStatus != "OPEN" || events[0].Order == nil || events[0].Kind != KindOrder {
+		t.Errorf("unexpected event: %+v", events[0])
+	}
+}
+
+func TestPollSkipsFailedLookups(t *testing.T) {
+	created := model.NewTimestamp(base.Add(-48 * time.Hour))
+	svc := &fakeOrders{open: []*model.Order{
+		{Id: "gone", Status: model.OrderStatusOpen, Created: created},
+		{Id: "flaky", Status: model.OrderStatusOpen, Created: created},
+	}}
+	rec := &recorder{}
+	store := NewMemoryStore()
+
+	f, err := New(svc, nil, nil, &Config{PortfolioId: "p1", Handler: rec.handle, Store: store, MaxGetFailures: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f.now = func() time.Time { return base }
+	ctx := context.Background()
+
+	if err := f.Poll(ctx, KindOrder); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec.take()
+
+	// Both orders leave the open list: one is not returned, the other fails to be fetched
+	svc.open = []*model.Order{{Id: "new", Status: model.OrderStatusOpen, Created: model.NewTimestamp(base)}}
+	svc.getErr = map[string]error{"flaky": errors.New("unavailable")}
+
+	if err := f.Poll(ctx, KindOrder); err == nil {
+		t.Fatal("expected the lookup errors to be reported")
+	}
+	assertEvents(t, []string{"CREATED:new:OPEN"}, rec.take())
+
+	state, _ := store.Load(ctx, "p1/ORDER")
+	if _, ok := state.Seen["gone"]; ok {
+		t.Error("expected the missing order to be dropped")
+	}
+	if seen := state.Seen["flaky"]; seen == nil || seen.Failures != 1 {
+		t.Fatalf("expected the flaky order to be kept, got %+v", seen)
+	}
+
+	if err := f.Poll(ctx, KindOrder); err == nil {
+		t.Fatal("expected the lookup error to be reported")
+	}
+	state, _ = store.Load(ctx, "p1/ORDER")
+	if _, ok := state.Seen["flaky"]; ok {
+		t.Error("expected the flaky order to expire after two failures")
+	}
+	if err := f.Poll(ctx, KindOrder); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	if state, err := store.Load(ctx, "p1/ORDER"); err != nil || state != nil {
+		t.Fatalf("expected no state, got %+v, %v", state, err)
+	}
+
+	saved := &State{Key: "p1/ORDER", HighWaterMark: base, Seen: map[string]*Seen{"o1": {Status: "OPEN", Version: "OPEN/0", Created: base}}}
+	if err := store.Save(ctx, saved); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	loaded, err := store.Load(ctx, "p1/ORDER")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !loaded.HighWaterMark.Equal(base) || loaded.Seen["o1"].Version != "OPEN/0" {
+		t.Errorf("unexpected state: %+v", loaded)
+	}
+}
+
+func TestNewValidatesConfig(t *testing.T) {
+	handler := func(ctx context.Context, e Event) error { return nil }
+
+	if _, err := New(nil, &fakeTransactions{}, nil, &Config{Handler: handler}); err == nil {
+		t.Error("expected missing portfolio error")
+	}
+	if _, err := New(nil, &fakeTransactions{}, nil, &Config{PortfolioId: "p1"}); err == nil {
+		t.Error("expected missing handler error")
+	}
+	if _, err := New(nil, nil, nil, &Config{PortfolioId: "p1", Handler: handler}); err == nil {
+		t.Error("expected missing services error")
+	}
+}
@@ -0,0 +1,135 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/internal/jsonfile"
+)
+
+// Seen is the last delivered version of an item
+type Seen struct {
+	Status   string    `json:"status"`
+	Version  string    `json:"version"`
+	Created  time.Time `json:"created"`
+	Terminal bool      `json:"terminal"`
+	// Failures counts consecutive failed lookups of an item that was not listed
+	Failures int `json:"failures,omitempty"`
+}
+
+// State is the progress of one stream
+type State struct {
+	// Key is the portfolio id and kind, e.g. "<portfolio id>/TRANSACTION"
+	Key string `json:"key"`
+	// HighWaterMark is the creation time of the newest delivered item
+	HighWaterMark time.Time `json:"high_water_mark"`
+	// Seen holds delivered items that are not terminal or are still inside the polling window, by id
+	Seen      map[string]*Seen `json:"seen"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+func (s *State) clone() *State {
+	cp := *s
+	cp.Seen = make(map[string]*Seen, len(s.Seen))
+	for id, seen := range s.Seen {
+		v := *seen
+		cp.Seen[id] = &v
+	}
+	return &cp
+}
+
+// Store persists stream state. Load returns nil, nil when no state exists for key.
+type Store interface {
+	Load(ctx context.Context, key string) (*State, error)
+	Save(ctx context.Context, state *State) error
+}
+
+// MemoryStore is an in-process Store. State is lost when the process exits.
+type MemoryStore struct {
+	mu     sync.Mutex
+	states map[string]*State
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{states: make(map[string]*State)}
+}
+
+func (s *MemoryStore) Load(ctx context.Context, key string) (*State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[key]
+	if !ok {
+		return nil, nil
+	}
+	return state.clone(), nil
+}
+
+func (s *MemoryStore) Save(ctx context.Context, state *State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[state.Key] = state.clone()
+	return nil
+}
+
+// FileStore keeps one JSON file per stream in a directory, replaced atomically on save
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore creates a store rooted at dir, creating the directory if needed
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create feed dir %s: %w", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(key string) string {
+	return filepath.Join(s.dir, url.PathEscape(key)+".json")
+}
+
+func (s *FileStore) Load(ctx context.Context, key string) (*State, error) {
+	b, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := &State{}
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil, fmt.Errorf("invalid feed state file %s: %w", s.path(key), err)
+	}
+	return state, nil
+}
+
+func (s *FileStore) Save(ctx context.Context, state *State) error {
+	return jsonfile.Write(s.path(state.Key), state)
+}
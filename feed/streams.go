@@ -0,0 +1,187 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package feed
+
+import (
+	"context"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/activities"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/coinbase-samples/prime-sdk-go/transactions"
+)
+
+// orderItem versions orders by status and filled quantity so partial fills are delivered as updates
+func orderItem(o *model.Order) *item {
+	return &item{
+		id:       o.Id,
+		status:   string(o.Status),
+		version:  string(o.Status) + "/" + o.FilledQuantity,
+		created:  o.Created.Time,
+		terminal: o.Status.IsTerminal(),
+		event:    Event{Order: o},
+	}
+}
+
+func transactionItem(t *model.Transaction) *item {
+	return &item{
+		id:       t.Id,
+		status:   string(t.Status),
+		version:  string(t.Status),
+		created:  t.Created.Time,
+		terminal: t.Status.IsTerminal(),
+		event:    Event{Transaction: t},
+	}
+}
+
+func activityItem(a *model.Activity) *item {
+	return &item{
+		id:       a.Id,
+		status:   string(a.Status),
+		version:  string(a.Status),
+		created:  a.Created.Time,
+		terminal: a.Status.IsTerminal(),
+		event:    Event{Activity: a},
+	}
+}
+
+// orderStream lists every open order and the orders created in the window. Open orders are listed
+// regardless of age, so only orders that closed after leaving the window need a GetOrder.
+func orderStream(service orders.OrdersService, portfolioId string, interval time.Duration) *stream {
+	return &stream{
+		kind:     KindOrder,
+		interval: interval,
+		list: func(ctx context.Context, start, end time.Time) ([]*item, error) {
+			open, err := service.ListOpenOrders(ctx, &orders.ListOpenOrdersRequest{PortfolioId: portfolioId})
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := service.ListOrders(ctx, &orders.ListOrdersRequest{
+				PortfolioId: portfolioId,
+				Start:       start,
+				End:         end,
+			})
+			if err != nil {
+				return nil, err
+			}
+			recent, err := resp.Iterator().WithConfig(nil).FetchAll(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			// ListOrders is the later read, so it wins for orders in both
+			seen := make(map[string]bool, len(recent))
+			items := make([]*item, 0, len(open.Orders)+len(recent))
+			for _, o := range recent {
+				seen[o.Id] = true
+				items = append(items, orderItem(o))
+			}
+			for _, o := range open.Orders {
+				if !seen[o.Id] {
+					items = append(items, orderItem(o))
+				}
+			}
+			return items, nil
+		},
+		get: func(ctx context.Context, id string) (*item, error) {
+			resp, err := service.GetOrder(ctx, &orders.GetOrderRequest{PortfolioId: portfolioId, OrderId: id})
+			if err != nil {
+				return nil, err
+			}
+			if resp.Order == nil {
+				return nil, errNotFound
+			}
+			return orderItem(resp.Order), nil
+		},
+	}
+}
+
+func transactionStream(service transactions.TransactionsService, portfolioId string, interval time.Duration) *stream {
+	return &stream{
+		kind:     KindTransaction,
+		interval: interval,
+		list: func(ctx context.Context, start, end time.Time) ([]*item, error) {
+			resp, err := service.ListPortfolioTransactions(ctx, &transactions.ListPortfolioTransactionsRequest{
+				PortfolioId: portfolioId,
+				Start:       start,
+				End:         end,
+			})
+			if err != nil {
+				return nil, err
+			}
+			txs, err := resp.Iterator().WithConfig(nil).FetchAll(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]*item, 0, len(txs))
+			for _, t := range txs {
+				items = append(items, transactionItem(t))
+			}
+			return items, nil
+		},
+		get: func(ctx context.Context, id string) (*item, error) {
+			resp, err := service.GetTransaction(ctx, &transactions.GetTransactionRequest{PortfolioId: portfolioId, TransactionId: id})
+			if err != nil {
+				return nil, err
+			}
+			if resp.Transaction == nil {
+				return nil, errNotFound
+			}
+			return transactionItem(resp.Transaction), nil
+		},
+	}
+}
+
+func activityStream(service activities.ActivitiesService, portfolioId string, interval time.Duration) *stream {
+	return &stream{
+		kind:     KindActivity,
+		interval: interval,
+		list: func(ctx context.Context, start, end time.Time) ([]*item, error) {
+			resp, err := service.ListActivities(ctx, &activities.ListActivitiesRequest{
+				PortfolioId: portfolioId,
+				Start:       start,
+				End:         end,
+			})
+			if err != nil {
+				return nil, err
+			}
+			acts, err := resp.Iterator().WithConfig(nil).FetchAll(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]*item, 0, len(acts))
+			for _, a := range acts {
+				items = append(items, activityItem(a))
+			}
+			return items, nil
+		},
+		get: func(ctx context.Context, id string) (*item, error) {
+			resp, err := service.GetActivity(ctx, &activities.GetActivityRequest{PortfolioId: portfolioId, Id: id})
+			if err != nil {
+				return nil, err
+			}
+			if resp.Activity == nil {
+				return nil, errNotFound
+			}
+			return activityItem(resp.Activity), nil
+		},
+	}
+}
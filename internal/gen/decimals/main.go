@@ -0,0 +1,203 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command decimals generates <Field>Num decimal accessors for the numeric string fields of the
+// structs in the model package. Run it with go generate from the model directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const output = "decimals_gen.go"
+
+// numericSuffixes are the trailing words of field names that hold amounts, prices, rates or sizes
+var numericSuffixes = map[string]bool{
+	"Accrual": true, "Addon": true, "On": true, "Adjustment": true, "Allocated": true,
+	"Amount": true, "Ask": true, "Available": true, "Balance": true, "Base": true,
+	"Basis": true, "Bid": true, "Bound": true, "Cap": true, "Close": true,
+	"Collateral": true, "Commission": true, "Consumed": true, "Contracts": true, "Credit": true,
+	"Days": true, "Deficit": true, "Equity": true, "Excess": true, "Exposure": true,
+	"Fee": true, "Fees": true, "High": true, "Holds": true, "Increment": true,
+	"Interest": true, "Leg": true, "Level": true, "Leverage": true, "Limit": true,
+	"Liquidity": true, "Long": true, "Low": true, "Margin": true, "Nominal": true,
+	"Notional": true, "Offset": true, "Open": true, "Ote": true, "Pnl": true,
+	"Pov": true, "Power": true, "Price": true, "Quantity": true, "Quote": true,
+	"Rate": true, "Ratio": true, "Requirement": true, "Return": true, "Short": true,
+	"Shortfall": true, "Size": true, "Slippage": true, "Sod": true, "Spread": true,
+	"Threshold": true, "Total": true, "Transfers": true, "Utilization": true, "Utilized": true,
+	"Value": true, "Vol": true, "Volume": true,
+}
+
+// numericFields are numeric fields whose names do not end with a numeric suffix
+var numericFields = map[string]bool{
+	"Adv30d": true, "Vol5d": true, "Vol30d": true, "Vol90d": true,
+	"LiquidityALong": true, "LiquidityAShort": true, "LiquidityBShort": true,
+}
+
+// excluded lists Type.Field pairs that match a numeric suffix but do not hold numbers
+var excluded = map[string]bool{
+	"ErrorMessage.Value":     true,
+	"Transfer.Value":         true,
+	"TransferLocation.Value": true,
+}
+
+var words = regexp.MustCompile(`[A-Z]+[a-z0-9]*|[a-z0-9]+`)
+
+type accessor struct {
+	typeName string
+	field    string
+	label    string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || path == output {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		files = append(files, f)
+	}
+
+	taken := make(map[string]bool)
+	receivers := make(map[string]string)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil {
+				continue
+			}
+			recv := fn.Recv.List[0]
+			typeName := receiverName(recv.Type)
+			taken[typeName+"."+fn.Name.Name] = true
+			if len(recv.Names) > 0 {
+				receivers[typeName] = recv.Names[0].Name
+			}
+		}
+	}
+
+	var accessors []accessor
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok || !spec.Name.IsExported() {
+				return true
+			}
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			for _, field := range st.Fields.List {
+				for _, name := range field.Names {
+					taken[spec.Name.Name+"."+name.Name] = true
+				}
+			}
+
+			for _, field := range st.Fields.List {
+				if ident, ok := field.Type.(*ast.Ident); !ok || ident.Name != "string" {
+					continue
+				}
+				for _, name := range field.Names {
+					key := spec.Name.Name + "." + name.Name
+					if !name.IsExported() || excluded[key] || taken[key+"Num"] || !isNumeric(name.Name) {
+						continue
+					}
+					accessors = append(accessors, accessor{
+						typeName: spec.Name.Name,
+						field:    name.Name,
+						label:    label(name.Name, field.Tag),
+					})
+				}
+			}
+			return true
+		})
+	}
+
+	sort.SliceStable(accessors, func(i, j int) bool { return accessors[i].typeName < accessors[j].typeName })
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/gen/decimals; DO NOT EDIT.\n\n")
+	buf.WriteString("package model\n\nimport \"github.com/shopspring/decimal\"\n")
+	for _, a := range accessors {
+		recv, ok := receivers[a.typeName]
+		if !ok {
+			recv = strings.ToLower(a.typeName[:1])
+		}
+		fmt.Fprintf(&buf, "\n// %sNum returns %s as a decimal. An empty %s is zero.\n", a.field, a.field, a.field)
+		fmt.Fprintf(&buf, "func (%s %s) %sNum() (decimal.Decimal, error) {\n", recv, a.typeName, a.field)
+		fmt.Fprintf(&buf, "\treturn parseDecimal(%q, %s.%s)\n}\n", a.label, recv, a.field)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func isNumeric(name string) bool {
+	if numericFields[name] {
+		return true
+	}
+	w := words.FindAllString(name, -1)
+	return len(w) > 0 && numericSuffixes[w[len(w)-1]]
+}
+
+// label returns the JSON name of a field, used in parse errors
+func label(name string, tag *ast.BasicLit) string {
+	if tag != nil {
+		jsonTag := reflect.StructTag(strings.Trim(tag.Value, "`")).Get("json")
+		if n := strings.Split(jsonTag, ",")[0]; len(n) > 0 && n != "-" {
+			return n
+		}
+	}
+	return name
+}
+
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
@@ -16,13 +16,163 @@
 
 package model
 
+// ActivityCategory groups activities by what they act on
+type ActivityCategory string
+
+const (
+	ActivityCategoryOther       ActivityCategory = "OTHER_ACTIVITY_CATEGORY"
+	ActivityCategoryOrder       ActivityCategory = "ACTIVITY_CATEGORY_ORDER"
+	ActivityCategoryTransaction ActivityCategory = "ACTIVITY_CATEGORY_TRANSACTION"
+	ActivityCategoryAccount     ActivityCategory = "ACTIVITY_CATEGORY_ACCOUNT"
+	ActivityCategoryAllocation  ActivityCategory = "ACTIVITY_CATEGORY_ALLOCATION"
+	ActivityCategoryLending     ActivityCategory = "ACTIVITY_CATEGORY_LENDING"
+)
+
+// IsValid returns true if c is an activity category listed in the API spec
+func (c ActivityCategory) IsValid() bool {
+	switch c {
+	case ActivityCategoryOther, ActivityCategoryOrder, ActivityCategoryTransaction,
+		ActivityCategoryAccount, ActivityCategoryAllocation, ActivityCategoryLending:
+		return true
+	}
+	return false
+}
+
+// ActivityStatus is the state of an activity
+type ActivityStatus string
+
+const (
+	ActivityStatusOther      ActivityStatus = "OTHER_ACTIVITY_STATUS"
+	ActivityStatusCancelled  ActivityStatus = "ACTIVITY_STATUS_CANCELLED"
+	ActivityStatusProcessing ActivityStatus = "ACTIVITY_STATUS_PROCESSING"
+	ActivityStatusCompleted  ActivityStatus = "ACTIVITY_STATUS_COMPLETED"
+	ActivityStatusExpired    ActivityStatus = "ACTIVITY_STATUS_EXPIRED"
+	ActivityStatusRejected   ActivityStatus = "ACTIVITY_STATUS_REJECTED"
+	ActivityStatusFailed     ActivityStatus = "ACTIVITY_STATUS_FAILED"
+)
+
+// IsValid returns true if s is an activity status listed in the API spec
+func (s ActivityStatus) IsValid() bool {
+	switch s {
+	case ActivityStatusOther, ActivityStatusCancelled, ActivityStatusProcessing,
+		ActivityStatusCompleted, ActivityStatusExpired, ActivityStatusRejected,
+		ActivityStatusFailed:
+		return true
+	}
+	return false
+}
+
+// IsTerminal returns true for statuses an activity never leaves
+func (s ActivityStatus) IsTerminal() bool {
+	switch s {
+	case ActivityStatusCancelled, ActivityStatusCompleted, ActivityStatusExpired,
+		ActivityStatusRejected, ActivityStatusFailed:
+		return true
+	}
+	return false
+}
+
+// ActivityType is the primary type of an activity
+type ActivityType string
+
+const (
+	ActivityTypeOther                 ActivityType = "OTHER_ACTIVITY_TYPE"
+	ActivityTypeLimitOrder            ActivityType = "ACTIVITY_TYPE_LIMIT_ORDER"
+	ActivityTypeMarketOrder           ActivityType = "ACTIVITY_TYPE_MARKET_ORDER"
+	ActivityTypeTwapOrder             ActivityType = "ACTIVITY_TYPE_TWAP_ORDER"
+	ActivityTypeBlockTrade            ActivityType = "ACTIVITY_TYPE_BLOCK_TRADE"
+	ActivityTypeVwapOrder             ActivityType = "ACTIVITY_TYPE_VWAP_ORDER"
+	ActivityTypeStopLimitOrder        ActivityType = "ACTIVITY_TYPE_STOP_LIMIT_ORDER"
+	ActivityTypeDeposit               ActivityType = "ACTIVITY_TYPE_DEPOSIT"
+	ActivityTypeWithdrawal            ActivityType = "ACTIVITY_TYPE_WITHDRAWAL"
+	ActivityTypeInternalTransfer      ActivityType = "ACTIVITY_TYPE_INTERNAL_TRANSFER"
+	ActivityTypeCreateWallet          ActivityType = "ACTIVITY_TYPE_CREATE_WALLET"
+	ActivityTypeRemoveWallet          ActivityType = "ACTIVITY_TYPE_REMOVE_WALLET"
+	ActivityTypeUpdateWallet          ActivityType = "ACTIVITY_TYPE_UPDATE_WALLET"
+	ActivityTypeCastVote              ActivityType = "ACTIVITY_TYPE_CAST_VOTE"
+	ActivityTypeEnableVoting          ActivityType = "ACTIVITY_TYPE_ENABLE_VOTING"
+	ActivityTypeStake                 ActivityType = "ACTIVITY_TYPE_STAKE"
+	ActivityTypeUnstake               ActivityType = "ACTIVITY_TYPE_UNSTAKE"
+	ActivityTypeChangeValidator       ActivityType = "ACTIVITY_TYPE_CHANGE_VALIDATOR"
+	ActivityTypeRestake               ActivityType = "ACTIVITY_TYPE_RESTAKE"
+	ActivityTypeAddressBook           ActivityType = "ACTIVITY_TYPE_ADDRESS_BOOK"
+	ActivityTypeTeamMembers           ActivityType = "ACTIVITY_TYPE_TEAM_MEMBERS"
+	ActivityTypeBilling               ActivityType = "ACTIVITY_TYPE_BILLING"
+	ActivityTypeSecurity              ActivityType = "ACTIVITY_TYPE_SECURITY"
+	ActivityTypeApi                   ActivityType = "ACTIVITY_TYPE_API"
+	ActivityTypeSettings              ActivityType = "ACTIVITY_TYPE_SETTINGS"
+	ActivityTypeSmartContract         ActivityType = "ACTIVITY_TYPE_SMART_CONTRACT"
+	ActivityTypeAllocationIn          ActivityType = "ACTIVITY_TYPE_ALLOCATION_IN"
+	ActivityTypeAllocationOut         ActivityType = "ACTIVITY_TYPE_ALLOCATION_OUT"
+	ActivityTypeAllocationInReversal  ActivityType = "ACTIVITY_TYPE_ALLOCATION_IN_REVERSAL"
+	ActivityTypeAllocationOutReversal ActivityType = "ACTIVITY_TYPE_ALLOCATION_OUT_REVERSAL"
+	ActivityTypeConversion            ActivityType = "ACTIVITY_TYPE_CONVERSION"
+	ActivityTypePrincipalOut          ActivityType = "ACTIVITY_TYPE_PRINCIPAL_OUT"
+	ActivityTypePrincipalIn           ActivityType = "ACTIVITY_TYPE_PRINCIPAL_IN"
+	ActivityTypeCollateralOut         ActivityType = "ACTIVITY_TYPE_COLLATERAL_OUT"
+	ActivityTypeCollateralIn          ActivityType = "ACTIVITY_TYPE_COLLATERAL_IN"
+	ActivityTypeInterestOut           ActivityType = "ACTIVITY_TYPE_INTEREST_OUT"
+	ActivityTypeInterestIn            ActivityType = "ACTIVITY_TYPE_INTEREST_IN"
+	ActivityTypeWeb3Message           ActivityType = "ACTIVITY_TYPE_WEB3_MESSAGE"
+	ActivityTypeWeb3Transaction       ActivityType = "ACTIVITY_TYPE_WEB3_TRANSACTION"
+	ActivityTypeWeb3DeviceRecovery    ActivityType = "ACTIVITY_TYPE_WEB3_DEVICE_RECOVERY"
+	ActivityTypeWeb3RecreateBackup    ActivityType = "ACTIVITY_TYPE_WEB3_RECREATE_BACKUP"
+	ActivityTypeWeb3Onboarding        ActivityType = "ACTIVITY_TYPE_WEB3_ONBOARDING"
+)
+
+// IsValid returns true if t is an activity type listed in the API spec
+func (t ActivityType) IsValid() bool {
+	switch t {
+	case ActivityTypeOther, ActivityTypeLimitOrder, ActivityTypeMarketOrder,
+		ActivityTypeTwapOrder, ActivityTypeBlockTrade, ActivityTypeVwapOrder,
+		ActivityTypeStopLimitOrder, ActivityTypeDeposit, ActivityTypeWithdrawal,
+		ActivityTypeInternalTransfer, ActivityTypeCreateWallet, ActivityTypeRemoveWallet,
+		ActivityTypeUpdateWallet, ActivityTypeCastVote, ActivityTypeEnableVoting,
+		ActivityTypeStake, ActivityTypeUnstake, ActivityTypeChangeValidator,
+		ActivityTypeRestake, ActivityTypeAddressBook, ActivityTypeTeamMembers,
+		ActivityTypeBilling, ActivityTypeSecurity, ActivityTypeApi,
+		ActivityTypeSettings, ActivityTypeSmartContract, ActivityTypeAllocationIn,
+		ActivityTypeAllocationOut, ActivityTypeAllocationInReversal, ActivityTypeAllocationOutReversal,
+		ActivityTypeConversion, ActivityTypePrincipalOut, ActivityTypePrincipalIn,
+		ActivityTypeCollateralOut, ActivityTypeCollateralIn, ActivityTypeInterestOut,
+		ActivityTypeInterestIn, ActivityTypeWeb3Message, ActivityTypeWeb3Transaction,
+		ActivityTypeWeb3DeviceRecovery, ActivityTypeWeb3RecreateBackup, ActivityTypeWeb3Onboarding:
+		return true
+	}
+	return false
+}
+
+// ActivitySecondaryType refines the primary type of an activity
+type ActivitySecondaryType string
+
+const (
+	ActivitySecondaryTypeNone              ActivitySecondaryType = "NO_SECONDARY_TYPE"
+	ActivitySecondaryTypeBuy               ActivitySecondaryType = "ACTIVITY_SECONDARY_TYPE_BUY"
+	ActivitySecondaryTypeSell              ActivitySecondaryType = "ACTIVITY_SECONDARY_TYPE_SELL"
+	ActivitySecondaryTypeInternalTransfer  ActivitySecondaryType = "ACTIVITY_SECONDARY_TYPE_INTERNAL_TRANSFER"
+	ActivitySecondaryTypeSweepTransferType ActivitySecondaryType = "ACTIVITY_SECONDARY_TYPE_SWEEP_TRANSFER_TYPE"
+	ActivitySecondaryTypeWeb3Signer        ActivitySecondaryType = "ACTIVITY_SECONDARY_TYPE_WEB3_SIGNER"
+	ActivitySecondaryTypeWeb3Wallet        ActivitySecondaryType = "ACTIVITY_SECONDARY_TYPE_WEB3_WALLET"
+)
+
+// IsValid returns true if t is an activity secondary type listed in the API spec
+func (t ActivitySecondaryType) IsValid() bool {
+	switch t {
+	case ActivitySecondaryTypeNone, ActivitySecondaryTypeBuy, ActivitySecondaryTypeSell,
+		ActivitySecondaryTypeInternalTransfer, ActivitySecondaryTypeSweepTransferType, ActivitySecondaryTypeWeb3Signer,
+		ActivitySecondaryTypeWeb3Wallet:
+		return true
+	}
+	return false
+}
+
 type Activity struct {
 	Id                  string                `json:"id"`
 	ReferenceId         string                `json:"reference_id"`
-	Category            string                `json:"category"`
-	PrimaryType         string                `json:"type"`
-	SecondaryType       string                `json:"secondary_type"`
-	Status              string                `json:"status"`
+	Category            ActivityCategory      `json:"category"`
+	PrimaryType         ActivityType          `json:"type"`
+	SecondaryType       ActivitySecondaryType `json:"secondary_type"`
+	Status              ActivityStatus        `json:"status"`
 	CreatedBy           string                `json:"created_by"`
 	Title               string                `json:"title"`
 	Description         string                `json:"description"`
@@ -31,8 +181,8 @@ type Activity struct {
 	OrdersMetadata      *OrdersMetadata       `json:"orders_metadata,omitempty"`
 	TransactionMetadata *TransactionsMetadata `json:"transaction_metadata,omitempty"`
 	Symbols             []string              `json:"symbols,omitempty"`
-	Created             string                `json:"created_at"`
-	Updated             string                `json:"updated_at"`
+	Created             Timestamp             `json:"created_at"`
+	Updated             Timestamp             `json:"updated_at"`
 }
 
 type TransactionsMetadata struct {
@@ -47,13 +197,13 @@ type AccountMetadata struct {
 type OrdersMetadata struct{}
 
 type Consensus struct {
-	ApprovalDeadline string `json:"approval_deadline"`
-	PassedConsensus  bool   `json:"has_passed_consensus"`
+	ApprovalDeadline Timestamp `json:"approval_deadline"`
+	PassedConsensus  bool      `json:"has_passed_consensus"`
 }
 
 type UserAction struct {
 	Action               string                `json:"action"`
 	UserId               string                `json:"user_id"`
-	Timestamp            string                `json:"timestamp"`
+	Timestamp            Timestamp             `json:"timestamp"`
 	TransactionsMetadata *TransactionsMetadata `json:"transactions_metadata,omitempty"`
 }
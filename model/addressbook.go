@@ -16,8 +16,6 @@
 
 package model
 
-import "time"
-
 type AddressBookType string
 
 const (
@@ -35,8 +33,8 @@ type AddressBookEntry struct {
 	AccountIdentifierName string                   `json:"account_identifier_name"`
 	State                 string                   `json:"state"`
 	ExplorerLink          string                   `json:"explorer_link"`
-	LastUsed              time.Time                `json:"last_used_at"`
-	Added                 time.Time                `json:"added_at"`
+	LastUsed              Timestamp                `json:"last_used_at"`
+	Added                 Timestamp                `json:"added_at"`
 	AddedBy               *AddressBookEntryAddedBy `json:"added_by"`
 	Type                  AddressBookType          `json:"type,omitempty"`
 	CounterpartyId        string                   `json:"counterparty_id,omitempty"`
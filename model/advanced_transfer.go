@@ -65,11 +65,22 @@ type FundMovement struct {
 	Amount   string            `json:"amount,omitempty"`
 }
 
+// Layouts of the compact settlement dates and times, for use with time.Parse and time.Format
+const (
+	SettlementDateLayout = "20060102"
+	SettlementTimeLayout = "1504"
+)
+
 // BlindMatchMetadata contains metadata specific to blind match advanced transfers.
+// The dates and time are strings rather than Timestamp because Prime expects them in the compact
+// SettlementDateLayout and SettlementTimeLayout formats, which Timestamp would encode as RFC3339.
 type BlindMatchMetadata struct {
-	ReferenceId    string `json:"reference_id,omitempty"`
+	ReferenceId string `json:"reference_id,omitempty"`
+	// SettlementDate is the intended settlement date, YYYYMMDD
 	SettlementDate string `json:"settlement_date,omitempty"`
-	TradeDate      string `json:"trade_date,omitempty"`
+	// TradeDate is the optional date of the original trade, YYYYMMDD
+	TradeDate string `json:"trade_date,omitempty"`
+	// SettlementTime is the optional settlement time of day in UTC, HHMM (default 09:30 Eastern Time)
 	SettlementTime string `json:"settlement_time,omitempty"`
 }
 
@@ -33,7 +33,7 @@ type AllocationDestination struct {
 type Allocation struct {
 	RootId        string                   `json:"root_id"`
 	ReversalId    string                   `json:"reversal_id"`
-	Completed     string                   `json:"allocation_completed_at"`
+	Completed     Timestamp                `json:"allocation_completed_at"`
 	UserId        string                   `json:"user_id"`
 	ProductId     string                   `json:"product_id"`
 	Side          string                   `json:"side"`
@@ -16,13 +16,6 @@
 
 package model
 
-import (
-	"fmt"
-
-	"github.com/coinbase-samples/core-go"
-	"github.com/shopspring/decimal"
-)
-
 const (
 	BalanceTypeTrading = "TRADING_BALANCES"
 	BalanceTypeVault   = "VAULT_BALANCES"
@@ -43,22 +36,6 @@ type Balance struct {
 	WithdrawableAmount   string `json:"withdrawable_amount"`
 }
 
-func (b Balance) AmountNum() (amount decimal.Decimal, err error) {
-	amount, err = core.StrToNum(b.Amount)
-	if err != nil {
-		err = fmt.Errorf("Invalid asset amount: %s - symbol: %s - msg: %v", b.Amount, b.Symbol, err)
-	}
-	return
-}
-
-func (b Balance) HoldsNum() (holds decimal.Decimal, err error) {
-	holds, err = core.StrToNum(b.Holds)
-	if err != nil {
-		err = fmt.Errorf("Invalid asset holds: %s - symbol: %s - msg: %v", b.Holds, b.Symbol, err)
-	}
-	return
-}
-
 type BalanceWithHolds struct {
 	Total string `json:"total"`
 	Holds string `json:"holds"`
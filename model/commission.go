@@ -16,25 +16,9 @@
 
 package model
 
-import (
-	"fmt"
-
-	"github.com/coinbase-samples/core-go"
-	"github.com/shopspring/decimal"
-)
-
 // Commission represents commission information
 type Commission struct {
 	Type          string `json:"type"`
 	Rate          string `json:"rate"`
 	TradingVolume string `json:"trading_volume"`
 }
-
-// RateNum converts the commission rate string to a decimal
-func (p Commission) RateNum() (rate decimal.Decimal, err error) {
-	rate, err = core.StrToNum(p.Rate)
-	if err != nil {
-		err = fmt.Errorf("Invalid commission rate: %s - err: %w", p.Rate, err)
-	}
-	return
-}
@@ -0,0 +1,39 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+//go:generate go run ../internal/gen/decimals
+
+// parseDecimal parses a numeric string field for the generated <Field>Num accessors. The API
+// omits unset amounts, so an empty string is zero; callers that need to tell unset from zero
+// check the string field itself.
+func parseDecimal(field, value string) (decimal.Decimal, error) {
+	if len(value) == 0 {
+		return decimal.Zero, nil
+	}
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid %s: %s - err: %w", field, value, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,47 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDecimalAccessors(t *testing.T) {
+	tx := Transaction{Amount: "1.50", Fees: ""}
+
+	amount, err := tx.AmountNum()
+	if err != nil || !amount.Equal(decimal.RequireFromString("1.5")) {
+		t.Fatalf("unexpected amount: %s %v", amount, err)
+	}
+
+	fees, err := tx.FeesNum()
+	if err != nil || !fees.IsZero() {
+		t.Fatalf("empty fees should be zero: %s %v", fees, err)
+	}
+
+	if holds, err := (Balance{Amount: "2"}).HoldsNum(); err != nil || !holds.IsZero() {
+		t.Fatalf("empty holds should be zero: %s %v", holds, err)
+	}
+
+	o := Order{FilledQuantity: "abc"}
+	if _, err := o.FilledQuantityNum(); err == nil || !strings.Contains(err.Error(), "filled_quantity") {
+		t.Fatalf("expected error naming the field, got %v", err)
+	}
+}
@@ -0,0 +1,1725 @@
+// Code generated by internal/gen/decimals; DO NOT EDIT.
+
+package model
+
+import "github.com/shopspring/decimal"
+
+// InterestRateNum returns InterestRate as a decimal. An empty InterestRate is zero.
+func (a Accrual) InterestRateNum() (decimal.Decimal, error) {
+	return parseDecimal("interest_rate", a.InterestRate)
+}
+
+// NominalAccrualNum returns NominalAccrual as a decimal. An empty NominalAccrual is zero.
+func (a Accrual) NominalAccrualNum() (decimal.Decimal, error) {
+	return parseDecimal("nominal_accrual", a.NominalAccrual)
+}
+
+// NotionalAccrualNum returns NotionalAccrual as a decimal. An empty NotionalAccrual is zero.
+func (a Accrual) NotionalAccrualNum() (decimal.Decimal, error) {
+	return parseDecimal("notional_accrual", a.NotionalAccrual)
+}
+
+// ConversionRateNum returns ConversionRate as a decimal. An empty ConversionRate is zero.
+func (a Accrual) ConversionRateNum() (decimal.Decimal, error) {
+	return parseDecimal("conversion_rate", a.ConversionRate)
+}
+
+// LoanAmountNum returns LoanAmount as a decimal. An empty LoanAmount is zero.
+func (a Accrual) LoanAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("loan_amount", a.LoanAmount)
+}
+
+// BenchmarkRateNum returns BenchmarkRate as a decimal. An empty BenchmarkRate is zero.
+func (a Accrual) BenchmarkRateNum() (decimal.Decimal, error) {
+	return parseDecimal("benchmark_rate", a.BenchmarkRate)
+}
+
+// SpreadNum returns Spread as a decimal. An empty Spread is zero.
+func (a Accrual) SpreadNum() (decimal.Decimal, error) {
+	return parseDecimal("spread", a.Spread)
+}
+
+// LoanAmountNotionalNum returns LoanAmountNotional as a decimal. An empty LoanAmountNotional is zero.
+func (a Accrual) LoanAmountNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("loan_amount_notional", a.LoanAmountNotional)
+}
+
+// NominalOpenBorrowSodNum returns NominalOpenBorrowSod as a decimal. An empty NominalOpenBorrowSod is zero.
+func (a Accrual) NominalOpenBorrowSodNum() (decimal.Decimal, error) {
+	return parseDecimal("nominal_open_borrow_sod", a.NominalOpenBorrowSod)
+}
+
+// NotionalOpenBorrowSodNum returns NotionalOpenBorrowSod as a decimal. An empty NotionalOpenBorrowSod is zero.
+func (a Accrual) NotionalOpenBorrowSodNum() (decimal.Decimal, error) {
+	return parseDecimal("notional_open_borrow_sod", a.NotionalOpenBorrowSod)
+}
+
+// ShortfallAmountNum returns ShortfallAmount as a decimal. An empty ShortfallAmount is zero.
+func (a ActiveLiquidationSummary) ShortfallAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("shortfall_amount", a.ShortfallAmount)
+}
+
+// AvgPriceNum returns AvgPrice as a decimal. An empty AvgPrice is zero.
+func (a Allocation) AvgPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("avg_price", a.AvgPrice)
+}
+
+// BaseQuantityNum returns BaseQuantity as a decimal. An empty BaseQuantity is zero.
+func (a Allocation) BaseQuantityNum() (decimal.Decimal, error) {
+	return parseDecimal("base_quantity", a.BaseQuantity)
+}
+
+// QuoteValueNum returns QuoteValue as a decimal. An empty QuoteValue is zero.
+func (a Allocation) QuoteValueNum() (decimal.Decimal, error) {
+	return parseDecimal("quote_value", a.QuoteValue)
+}
+
+// FeesAllocatedNum returns FeesAllocated as a decimal. An empty FeesAllocated is zero.
+func (a Allocation) FeesAllocatedNum() (decimal.Decimal, error) {
+	return parseDecimal("fees_allocated", a.FeesAllocated)
+}
+
+// AllocationBaseNum returns AllocationBase as a decimal. An empty AllocationBase is zero.
+func (a AllocationDestination) AllocationBaseNum() (decimal.Decimal, error) {
+	return parseDecimal("allocation_base", a.AllocationBase)
+}
+
+// AllocationQuoteNum returns AllocationQuote as a decimal. An empty AllocationQuote is zero.
+func (a AllocationDestination) AllocationQuoteNum() (decimal.Decimal, error) {
+	return parseDecimal("allocation_quote", a.AllocationQuote)
+}
+
+// FeesAllocatedLegNum returns FeesAllocatedLeg as a decimal. An empty FeesAllocatedLeg is zero.
+func (a AllocationDestination) FeesAllocatedLegNum() (decimal.Decimal, error) {
+	return parseDecimal("fees_allocated_leg", a.FeesAllocatedLeg)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (a AllocationLeg) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", a.Amount)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (a AmountDue) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", a.Amount)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (a AssetBalance) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", a.Amount)
+}
+
+// NotionalAmountNum returns NotionalAmount as a decimal. An empty NotionalAmount is zero.
+func (a AssetBalance) NotionalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("notional_amount", a.NotionalAmount)
+}
+
+// ConversionRateNum returns ConversionRate as a decimal. An empty ConversionRate is zero.
+func (a AssetBalance) ConversionRateNum() (decimal.Decimal, error) {
+	return parseDecimal("conversion_rate", a.ConversionRate)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (a AssetChange) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", a.Amount)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (b Balance) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", b.Amount)
+}
+
+// HoldsNum returns Holds as a decimal. An empty Holds is zero.
+func (b Balance) HoldsNum() (decimal.Decimal, error) {
+	return parseDecimal("holds", b.Holds)
+}
+
+// BondedAmountNum returns BondedAmount as a decimal. An empty BondedAmount is zero.
+func (b Balance) BondedAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("bonded_amount", b.BondedAmount)
+}
+
+// ReservedAmountNum returns ReservedAmount as a decimal. An empty ReservedAmount is zero.
+func (b Balance) ReservedAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("reserved_amount", b.ReservedAmount)
+}
+
+// UnbondingAmountNum returns UnbondingAmount as a decimal. An empty UnbondingAmount is zero.
+func (b Balance) UnbondingAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("unbonding_amount", b.UnbondingAmount)
+}
+
+// UnvestedAmountNum returns UnvestedAmount as a decimal. An empty UnvestedAmount is zero.
+func (b Balance) UnvestedAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("unvested_amount", b.UnvestedAmount)
+}
+
+// PendingRewardsAmountNum returns PendingRewardsAmount as a decimal. An empty PendingRewardsAmount is zero.
+func (b Balance) PendingRewardsAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("pending_rewards_amount", b.PendingRewardsAmount)
+}
+
+// PastRewardsAmountNum returns PastRewardsAmount as a decimal. An empty PastRewardsAmount is zero.
+func (b Balance) PastRewardsAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("past_rewards_amount", b.PastRewardsAmount)
+}
+
+// BondableAmountNum returns BondableAmount as a decimal. An empty BondableAmount is zero.
+func (b Balance) BondableAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("bondable_amount", b.BondableAmount)
+}
+
+// WithdrawableAmountNum returns WithdrawableAmount as a decimal. An empty WithdrawableAmount is zero.
+func (b Balance) WithdrawableAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("withdrawable_amount", b.WithdrawableAmount)
+}
+
+// TotalNum returns Total as a decimal. An empty Total is zero.
+func (b BalanceWithHolds) TotalNum() (decimal.Decimal, error) {
+	return parseDecimal("total", b.Total)
+}
+
+// HoldsNum returns Holds as a decimal. An empty Holds is zero.
+func (b BalanceWithHolds) HoldsNum() (decimal.Decimal, error) {
+	return parseDecimal("holds", b.Holds)
+}
+
+// BaseBuyingPowerNum returns BaseBuyingPower as a decimal. An empty BaseBuyingPower is zero.
+func (b BuyingPower) BaseBuyingPowerNum() (decimal.Decimal, error) {
+	return parseDecimal("base_buying_power", b.BaseBuyingPower)
+}
+
+// QuoteBuyingPowerNum returns QuoteBuyingPower as a decimal. An empty QuoteBuyingPower is zero.
+func (b BuyingPower) QuoteBuyingPowerNum() (decimal.Decimal, error) {
+	return parseDecimal("quote_buying_power", b.QuoteBuyingPower)
+}
+
+// OpenNum returns Open as a decimal. An empty Open is zero.
+func (c Candle) OpenNum() (decimal.Decimal, error) {
+	return parseDecimal("open", c.Open)
+}
+
+// HighNum returns High as a decimal. An empty High is zero.
+func (c Candle) HighNum() (decimal.Decimal, error) {
+	return parseDecimal("high", c.High)
+}
+
+// LowNum returns Low as a decimal. An empty Low is zero.
+func (c Candle) LowNum() (decimal.Decimal, error) {
+	return parseDecimal("low", c.Low)
+}
+
+// CloseNum returns Close as a decimal. An empty Close is zero.
+func (c Candle) CloseNum() (decimal.Decimal, error) {
+	return parseDecimal("close", c.Close)
+}
+
+// VolumeNum returns Volume as a decimal. An empty Volume is zero.
+func (c Candle) VolumeNum() (decimal.Decimal, error) {
+	return parseDecimal("volume", c.Volume)
+}
+
+// RateNum returns Rate as a decimal. An empty Rate is zero.
+func (c Commission) RateNum() (decimal.Decimal, error) {
+	return parseDecimal("rate", c.Rate)
+}
+
+// TradingVolumeNum returns TradingVolume as a decimal. An empty TradingVolume is zero.
+func (c Commission) TradingVolumeNum() (decimal.Decimal, error) {
+	return parseDecimal("trading_volume", c.TradingVolume)
+}
+
+// TotalCommissionNum returns TotalCommission as a decimal. An empty TotalCommission is zero.
+func (c CommissionDetailTotal) TotalCommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("total_commission", c.TotalCommission)
+}
+
+// ClientCommissionNum returns ClientCommission as a decimal. An empty ClientCommission is zero.
+func (c CommissionDetailTotal) ClientCommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("client_commission", c.ClientCommission)
+}
+
+// VenueCommissionNum returns VenueCommission as a decimal. An empty VenueCommission is zero.
+func (c CommissionDetailTotal) VenueCommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("venue_commission", c.VenueCommission)
+}
+
+// CesCommissionNum returns CesCommission as a decimal. An empty CesCommission is zero.
+func (c CommissionDetailTotal) CesCommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("ces_commission", c.CesCommission)
+}
+
+// FinancingCommissionNum returns FinancingCommission as a decimal. An empty FinancingCommission is zero.
+func (c CommissionDetailTotal) FinancingCommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("financing_commission", c.FinancingCommission)
+}
+
+// RegulatoryCommissionNum returns RegulatoryCommission as a decimal. An empty RegulatoryCommission is zero.
+func (c CommissionDetailTotal) RegulatoryCommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("regulatory_commission", c.RegulatoryCommission)
+}
+
+// ClearingCommissionNum returns ClearingCommission as a decimal. An empty ClearingCommission is zero.
+func (c CommissionDetailTotal) ClearingCommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("clearing_commission", c.ClearingCommission)
+}
+
+// TfBalanceNum returns TfBalance as a decimal. An empty TfBalance is zero.
+func (c ConversionDetail) TfBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("tf_balance", c.TfBalance)
+}
+
+// NotionalTfBalanceNum returns NotionalTfBalance as a decimal. An empty NotionalTfBalance is zero.
+func (c ConversionDetail) NotionalTfBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("notional_tf_balance", c.NotionalTfBalance)
+}
+
+// ConvertedBalanceNum returns ConvertedBalance as a decimal. An empty ConvertedBalance is zero.
+func (c ConversionDetail) ConvertedBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("converted_balance", c.ConvertedBalance)
+}
+
+// NotionalConvertedBalanceNum returns NotionalConvertedBalance as a decimal. An empty NotionalConvertedBalance is zero.
+func (c ConversionDetail) NotionalConvertedBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("notional_converted_balance", c.NotionalConvertedBalance)
+}
+
+// InterestRateNum returns InterestRate as a decimal. An empty InterestRate is zero.
+func (c ConversionDetail) InterestRateNum() (decimal.Decimal, error) {
+	return parseDecimal("interest_rate", c.InterestRate)
+}
+
+// ConversionRateNum returns ConversionRate as a decimal. An empty ConversionRate is zero.
+func (c ConversionDetail) ConversionRateNum() (decimal.Decimal, error) {
+	return parseDecimal("conversion_rate", c.ConversionRate)
+}
+
+// CashBalanceNum returns CashBalance as a decimal. An empty CashBalance is zero.
+func (c CrossMarginPrimeDerivativesEquityBreakdown) CashBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("cash_balance", c.CashBalance)
+}
+
+// UnrealizedPnlNum returns UnrealizedPnl as a decimal. An empty UnrealizedPnl is zero.
+func (c CrossMarginPrimeDerivativesEquityBreakdown) UnrealizedPnlNum() (decimal.Decimal, error) {
+	return parseDecimal("unrealized_pnl", c.UnrealizedPnl)
+}
+
+// RealizedPnlNum returns RealizedPnl as a decimal. An empty RealizedPnl is zero.
+func (c CrossMarginPrimeDerivativesEquityBreakdown) RealizedPnlNum() (decimal.Decimal, error) {
+	return parseDecimal("realized_pnl", c.RealizedPnl)
+}
+
+// AccruedFundingPnlNum returns AccruedFundingPnl as a decimal. An empty AccruedFundingPnl is zero.
+func (c CrossMarginPrimeDerivativesEquityBreakdown) AccruedFundingPnlNum() (decimal.Decimal, error) {
+	return parseDecimal("accrued_funding_pnl", c.AccruedFundingPnl)
+}
+
+// MarginRequirementNum returns MarginRequirement as a decimal. An empty MarginRequirement is zero.
+func (c CrossMarginPrimeMarginSummary) MarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("margin_requirement", c.MarginRequirement)
+}
+
+// AccountEquityNum returns AccountEquity as a decimal. An empty AccountEquity is zero.
+func (c CrossMarginPrimeMarginSummary) AccountEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("account_equity", c.AccountEquity)
+}
+
+// MarginExcessShortfallNum returns MarginExcessShortfall as a decimal. An empty MarginExcessShortfall is zero.
+func (c CrossMarginPrimeMarginSummary) MarginExcessShortfallNum() (decimal.Decimal, error) {
+	return parseDecimal("margin_excess_shortfall", c.MarginExcessShortfall)
+}
+
+// ConsumedCreditNum returns ConsumedCredit as a decimal. An empty ConsumedCredit is zero.
+func (c CrossMarginPrimeMarginSummary) ConsumedCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("consumed_credit", c.ConsumedCredit)
+}
+
+// XmCreditLimitNum returns XmCreditLimit as a decimal. An empty XmCreditLimit is zero.
+func (c CrossMarginPrimeMarginSummary) XmCreditLimitNum() (decimal.Decimal, error) {
+	return parseDecimal("xm_credit_limit", c.XmCreditLimit)
+}
+
+// XmMarginLimitNum returns XmMarginLimit as a decimal. An empty XmMarginLimit is zero.
+func (c CrossMarginPrimeMarginSummary) XmMarginLimitNum() (decimal.Decimal, error) {
+	return parseDecimal("xm_margin_limit", c.XmMarginLimit)
+}
+
+// ConsumedMarginLimitNum returns ConsumedMarginLimit as a decimal. An empty ConsumedMarginLimit is zero.
+func (c CrossMarginPrimeMarginSummary) ConsumedMarginLimitNum() (decimal.Decimal, error) {
+	return parseDecimal("consumed_margin_limit", c.ConsumedMarginLimit)
+}
+
+// SpotEquityNum returns SpotEquity as a decimal. An empty SpotEquity is zero.
+func (c CrossMarginPrimeMarginSummary) SpotEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("spot_equity", c.SpotEquity)
+}
+
+// FuturesEquityNum returns FuturesEquity as a decimal. An empty FuturesEquity is zero.
+func (c CrossMarginPrimeMarginSummary) FuturesEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_equity", c.FuturesEquity)
+}
+
+// GrossMarketValueNum returns GrossMarketValue as a decimal. An empty GrossMarketValue is zero.
+func (c CrossMarginPrimeMarginSummary) GrossMarketValueNum() (decimal.Decimal, error) {
+	return parseDecimal("gross_market_value", c.GrossMarketValue)
+}
+
+// NetMarketValueNum returns NetMarketValue as a decimal. An empty NetMarketValue is zero.
+func (c CrossMarginPrimeMarginSummary) NetMarketValueNum() (decimal.Decimal, error) {
+	return parseDecimal("net_market_value", c.NetMarketValue)
+}
+
+// NetExposureNum returns NetExposure as a decimal. An empty NetExposure is zero.
+func (c CrossMarginPrimeMarginSummary) NetExposureNum() (decimal.Decimal, error) {
+	return parseDecimal("net_exposure", c.NetExposure)
+}
+
+// GrossLeverageNum returns GrossLeverage as a decimal. An empty GrossLeverage is zero.
+func (c CrossMarginPrimeMarginSummary) GrossLeverageNum() (decimal.Decimal, error) {
+	return parseDecimal("gross_leverage", c.GrossLeverage)
+}
+
+// EquityRatioNum returns EquityRatio as a decimal. An empty EquityRatio is zero.
+func (c CrossMarginPrimeMarginSummary) EquityRatioNum() (decimal.Decimal, error) {
+	return parseDecimal("equity_ratio", c.EquityRatio)
+}
+
+// DeficitRatioNum returns DeficitRatio as a decimal. An empty DeficitRatio is zero.
+func (c CrossMarginPrimeMarginSummary) DeficitRatioNum() (decimal.Decimal, error) {
+	return parseDecimal("deficit_ratio", c.DeficitRatio)
+}
+
+// FcmExcessAvailableToReturnNum returns FcmExcessAvailableToReturn as a decimal. An empty FcmExcessAvailableToReturn is zero.
+func (c CrossMarginPrimeMarginSummary) FcmExcessAvailableToReturnNum() (decimal.Decimal, error) {
+	return parseDecimal("fcm_excess_available_to_return", c.FcmExcessAvailableToReturn)
+}
+
+// DcoMarginRequirementNum returns DcoMarginRequirement as a decimal. An empty DcoMarginRequirement is zero.
+func (c CrossMarginPrimeRiskNettingInfo) DcoMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("dco_margin_requirement", c.DcoMarginRequirement)
+}
+
+// PortfolioMarginRequirementNum returns PortfolioMarginRequirement as a decimal. An empty PortfolioMarginRequirement is zero.
+func (c CrossMarginPrimeRiskNettingInfo) PortfolioMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("portfolio_margin_requirement", c.PortfolioMarginRequirement)
+}
+
+// IntegratedPortfolioMarginRequirementNum returns IntegratedPortfolioMarginRequirement as a decimal. An empty IntegratedPortfolioMarginRequirement is zero.
+func (c CrossMarginPrimeRiskNettingInfo) IntegratedPortfolioMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("integrated_portfolio_margin_requirement", c.IntegratedPortfolioMarginRequirement)
+}
+
+// IneligibleFuturesMarginRequirementNum returns IneligibleFuturesMarginRequirement as a decimal. An empty IneligibleFuturesMarginRequirement is zero.
+func (c CrossMarginPrimeRiskNettingInfo) IneligibleFuturesMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("ineligible_futures_margin_requirement", c.IneligibleFuturesMarginRequirement)
+}
+
+// CashBalanceNum returns CashBalance as a decimal. An empty CashBalance is zero.
+func (c CrossMarginPrimeSpotEquityBreakdown) CashBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("cash_balance", c.CashBalance)
+}
+
+// LongMarketValueNum returns LongMarketValue as a decimal. An empty LongMarketValue is zero.
+func (c CrossMarginPrimeSpotEquityBreakdown) LongMarketValueNum() (decimal.Decimal, error) {
+	return parseDecimal("long_market_value", c.LongMarketValue)
+}
+
+// ShortMarketValueNum returns ShortMarketValue as a decimal. An empty ShortMarketValue is zero.
+func (c CrossMarginPrimeSpotEquityBreakdown) ShortMarketValueNum() (decimal.Decimal, error) {
+	return parseDecimal("short_market_value", c.ShortMarketValue)
+}
+
+// ShortCollateralNum returns ShortCollateral as a decimal. An empty ShortCollateral is zero.
+func (c CrossMarginPrimeSpotEquityBreakdown) ShortCollateralNum() (decimal.Decimal, error) {
+	return parseDecimal("short_collateral", c.ShortCollateral)
+}
+
+// PendingTransfersNum returns PendingTransfers as a decimal. An empty PendingTransfers is zero.
+func (c CrossMarginPrimeSpotEquityBreakdown) PendingTransfersNum() (decimal.Decimal, error) {
+	return parseDecimal("pending_transfers", c.PendingTransfers)
+}
+
+// MarketPriceNum returns MarketPrice as a decimal. An empty MarketPrice is zero.
+func (c CrossMarginPrimeXMPosition) MarketPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("market_price", c.MarketPrice)
+}
+
+// SpotBalanceNum returns SpotBalance as a decimal. An empty SpotBalance is zero.
+func (c CrossMarginPrimeXMPosition) SpotBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("spot_balance", c.SpotBalance)
+}
+
+// SpotBalanceNotionalNum returns SpotBalanceNotional as a decimal. An empty SpotBalanceNotional is zero.
+func (c CrossMarginPrimeXMPosition) SpotBalanceNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("spot_balance_notional", c.SpotBalanceNotional)
+}
+
+// FuturesBalanceNum returns FuturesBalance as a decimal. An empty FuturesBalance is zero.
+func (c CrossMarginPrimeXMPosition) FuturesBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_balance", c.FuturesBalance)
+}
+
+// FuturesBalanceNotionalNum returns FuturesBalanceNotional as a decimal. An empty FuturesBalanceNotional is zero.
+func (c CrossMarginPrimeXMPosition) FuturesBalanceNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_balance_notional", c.FuturesBalanceNotional)
+}
+
+// BaseRequirementNum returns BaseRequirement as a decimal. An empty BaseRequirement is zero.
+func (c CrossMarginPrimeXMPosition) BaseRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("base_requirement", c.BaseRequirement)
+}
+
+// TotalPositionMarginNum returns TotalPositionMargin as a decimal. An empty TotalPositionMargin is zero.
+func (c CrossMarginPrimeXMPosition) TotalPositionMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("total_position_margin", c.TotalPositionMargin)
+}
+
+// BasisCreditNum returns BasisCredit as a decimal. An empty BasisCredit is zero.
+func (c CrossMarginPrimeXMPosition) BasisCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("basis_credit", c.BasisCredit)
+}
+
+// FuturesNettedNotionalNum returns FuturesNettedNotional as a decimal. An empty FuturesNettedNotional is zero.
+func (c CrossMarginPrimeXMPosition) FuturesNettedNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_netted_notional", c.FuturesNettedNotional)
+}
+
+// FuturesNettingMarginNum returns FuturesNettingMargin as a decimal. An empty FuturesNettingMargin is zero.
+func (c CrossMarginPrimeXMPosition) FuturesNettingMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_netting_margin", c.FuturesNettingMargin)
+}
+
+// LongAmountNum returns LongAmount as a decimal. An empty LongAmount is zero.
+func (c CrossMarginPrimeXMPosition) LongAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("long_amount", c.LongAmount)
+}
+
+// ShortAmountNum returns ShortAmount as a decimal. An empty ShortAmount is zero.
+func (c CrossMarginPrimeXMPosition) ShortAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("short_amount", c.ShortAmount)
+}
+
+// VolatilityAddonNum returns VolatilityAddon as a decimal. An empty VolatilityAddon is zero.
+func (c CrossMarginPrimeXMPosition) VolatilityAddonNum() (decimal.Decimal, error) {
+	return parseDecimal("volatility_addon", c.VolatilityAddon)
+}
+
+// LiquidityAddonNum returns LiquidityAddon as a decimal. An empty LiquidityAddon is zero.
+func (c CrossMarginPrimeXMPosition) LiquidityAddonNum() (decimal.Decimal, error) {
+	return parseDecimal("liquidity_addon", c.LiquidityAddon)
+}
+
+// BaseRatioLongNum returns BaseRatioLong as a decimal. An empty BaseRatioLong is zero.
+func (c CrossMarginRiskParameters) BaseRatioLongNum() (decimal.Decimal, error) {
+	return parseDecimal("base_ratio_long", c.BaseRatioLong)
+}
+
+// BaseRatioShortNum returns BaseRatioShort as a decimal. An empty BaseRatioShort is zero.
+func (c CrossMarginRiskParameters) BaseRatioShortNum() (decimal.Decimal, error) {
+	return parseDecimal("base_ratio_short", c.BaseRatioShort)
+}
+
+// VolatilityRateLongNum returns VolatilityRateLong as a decimal. An empty VolatilityRateLong is zero.
+func (c CrossMarginRiskParameters) VolatilityRateLongNum() (decimal.Decimal, error) {
+	return parseDecimal("volatility_rate_long", c.VolatilityRateLong)
+}
+
+// VolatilityRateShortNum returns VolatilityRateShort as a decimal. An empty VolatilityRateShort is zero.
+func (c CrossMarginRiskParameters) VolatilityRateShortNum() (decimal.Decimal, error) {
+	return parseDecimal("volatility_rate_short", c.VolatilityRateShort)
+}
+
+// VolatilityLowThresholdNum returns VolatilityLowThreshold as a decimal. An empty VolatilityLowThreshold is zero.
+func (c CrossMarginRiskParameters) VolatilityLowThresholdNum() (decimal.Decimal, error) {
+	return parseDecimal("volatility_low_threshold", c.VolatilityLowThreshold)
+}
+
+// VolatilityHighThresholdNum returns VolatilityHighThreshold as a decimal. An empty VolatilityHighThreshold is zero.
+func (c CrossMarginRiskParameters) VolatilityHighThresholdNum() (decimal.Decimal, error) {
+	return parseDecimal("volatility_high_threshold", c.VolatilityHighThreshold)
+}
+
+// LiquidityALongNum returns LiquidityALong as a decimal. An empty LiquidityALong is zero.
+func (c CrossMarginRiskParameters) LiquidityALongNum() (decimal.Decimal, error) {
+	return parseDecimal("liquidity_a_long", c.LiquidityALong)
+}
+
+// LiquidityAShortNum returns LiquidityAShort as a decimal. An empty LiquidityAShort is zero.
+func (c CrossMarginRiskParameters) LiquidityAShortNum() (decimal.Decimal, error) {
+	return parseDecimal("liquidity_a_short", c.LiquidityAShort)
+}
+
+// LiquidityBShortNum returns LiquidityBShort as a decimal. An empty LiquidityBShort is zero.
+func (c CrossMarginRiskParameters) LiquidityBShortNum() (decimal.Decimal, error) {
+	return parseDecimal("liquidity_b_short", c.LiquidityBShort)
+}
+
+// LiquidityThresholdNum returns LiquidityThreshold as a decimal. An empty LiquidityThreshold is zero.
+func (c CrossMarginRiskParameters) LiquidityThresholdNum() (decimal.Decimal, error) {
+	return parseDecimal("liquidity_threshold", c.LiquidityThreshold)
+}
+
+// BasisOffsetCreditRateNum returns BasisOffsetCreditRate as a decimal. An empty BasisOffsetCreditRate is zero.
+func (c CrossMarginRiskParameters) BasisOffsetCreditRateNum() (decimal.Decimal, error) {
+	return parseDecimal("basis_offset_credit_rate", c.BasisOffsetCreditRate)
+}
+
+// NetUsdValueNum returns NetUsdValue as a decimal. An empty NetUsdValue is zero.
+func (d DefiBalance) NetUsdValueNum() (decimal.Decimal, error) {
+	return parseDecimal("net_usd_value", d.NetUsdValue)
+}
+
+// PriceNum returns Price as a decimal. An empty Price is zero.
+func (e EditHistory) PriceNum() (decimal.Decimal, error) {
+	return parseDecimal("price", e.Price)
+}
+
+// BaseQuantityNum returns BaseQuantity as a decimal. An empty BaseQuantity is zero.
+func (e EditHistory) BaseQuantityNum() (decimal.Decimal, error) {
+	return parseDecimal("base_quantity", e.BaseQuantity)
+}
+
+// QuoteValueNum returns QuoteValue as a decimal. An empty QuoteValue is zero.
+func (e EditHistory) QuoteValueNum() (decimal.Decimal, error) {
+	return parseDecimal("quote_value", e.QuoteValue)
+}
+
+// DisplayBaseSizeNum returns DisplayBaseSize as a decimal. An empty DisplayBaseSize is zero.
+func (e EditHistory) DisplayBaseSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("display_base_size", e.DisplayBaseSize)
+}
+
+// DisplayQuoteSizeNum returns DisplayQuoteSize as a decimal. An empty DisplayQuoteSize is zero.
+func (e EditHistory) DisplayQuoteSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("display_quote_size", e.DisplayQuoteSize)
+}
+
+// StopPriceNum returns StopPrice as a decimal. An empty StopPrice is zero.
+func (e EditHistory) StopPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("stop_price", e.StopPrice)
+}
+
+// LongAmountNum returns LongAmount as a decimal. An empty LongAmount is zero.
+func (e EntityBalance) LongAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("long_amount", e.LongAmount)
+}
+
+// LongNotionalNum returns LongNotional as a decimal. An empty LongNotional is zero.
+func (e EntityBalance) LongNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("long_notional", e.LongNotional)
+}
+
+// ShortAmountNum returns ShortAmount as a decimal. An empty ShortAmount is zero.
+func (e EntityBalance) ShortAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("short_amount", e.ShortAmount)
+}
+
+// ShortNotionalNum returns ShortNotional as a decimal. An empty ShortNotional is zero.
+func (e EntityBalance) ShortNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("short_notional", e.ShortNotional)
+}
+
+// LongNum returns Long as a decimal. An empty Long is zero.
+func (e EntityPosition) LongNum() (decimal.Decimal, error) {
+	return parseDecimal("long", e.Long)
+}
+
+// ShortNum returns Short as a decimal. An empty Short is zero.
+func (e EntityPosition) ShortNum() (decimal.Decimal, error) {
+	return parseDecimal("short", e.Short)
+}
+
+// LowerBoundNum returns LowerBound as a decimal. An empty LowerBound is zero.
+func (e EstimatedNetworkFees) LowerBoundNum() (decimal.Decimal, error) {
+	return parseDecimal("lower_bound", e.LowerBound)
+}
+
+// UpperBoundNum returns UpperBound as a decimal. An empty UpperBound is zero.
+func (e EstimatedNetworkFees) UpperBoundNum() (decimal.Decimal, error) {
+	return parseDecimal("upper_bound", e.UpperBound)
+}
+
+// CfmUsdBalanceNum returns CfmUsdBalance as a decimal. An empty CfmUsdBalance is zero.
+func (f FcmBalance) CfmUsdBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("cfm_usd_balance", f.CfmUsdBalance)
+}
+
+// UnrealizedPnlNum returns UnrealizedPnl as a decimal. An empty UnrealizedPnl is zero.
+func (f FcmBalance) UnrealizedPnlNum() (decimal.Decimal, error) {
+	return parseDecimal("unrealized_pnl", f.UnrealizedPnl)
+}
+
+// DailyRealizedPnlNum returns DailyRealizedPnl as a decimal. An empty DailyRealizedPnl is zero.
+func (f FcmBalance) DailyRealizedPnlNum() (decimal.Decimal, error) {
+	return parseDecimal("daily_realized_pnl", f.DailyRealizedPnl)
+}
+
+// ExcessLiquidityNum returns ExcessLiquidity as a decimal. An empty ExcessLiquidity is zero.
+func (f FcmBalance) ExcessLiquidityNum() (decimal.Decimal, error) {
+	return parseDecimal("excess_liquidity", f.ExcessLiquidity)
+}
+
+// FuturesBuyingPowerNum returns FuturesBuyingPower as a decimal. An empty FuturesBuyingPower is zero.
+func (f FcmBalance) FuturesBuyingPowerNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_buying_power", f.FuturesBuyingPower)
+}
+
+// InitialMarginNum returns InitialMargin as a decimal. An empty InitialMargin is zero.
+func (f FcmBalance) InitialMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("initial_margin", f.InitialMargin)
+}
+
+// MaintenanceMarginNum returns MaintenanceMargin as a decimal. An empty MaintenanceMargin is zero.
+func (f FcmBalance) MaintenanceMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("maintenance_margin", f.MaintenanceMargin)
+}
+
+// InitialAmountNum returns InitialAmount as a decimal. An empty InitialAmount is zero.
+func (f FcmMarginCall) InitialAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("initial_amount", f.InitialAmount)
+}
+
+// RemainingAmountNum returns RemainingAmount as a decimal. An empty RemainingAmount is zero.
+func (f FcmMarginCall) RemainingAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("remaining_amount", f.RemainingAmount)
+}
+
+// NumberOfContractsNum returns NumberOfContracts as a decimal. An empty NumberOfContracts is zero.
+func (f FcmPosition) NumberOfContractsNum() (decimal.Decimal, error) {
+	return parseDecimal("number_of_contracts", f.NumberOfContracts)
+}
+
+// DailyRealizedPnlNum returns DailyRealizedPnl as a decimal. An empty DailyRealizedPnl is zero.
+func (f FcmPosition) DailyRealizedPnlNum() (decimal.Decimal, error) {
+	return parseDecimal("daily_realized_pnl", f.DailyRealizedPnl)
+}
+
+// UnrealizedPnlNum returns UnrealizedPnl as a decimal. An empty UnrealizedPnl is zero.
+func (f FcmPosition) UnrealizedPnlNum() (decimal.Decimal, error) {
+	return parseDecimal("unrealized_pnl", f.UnrealizedPnl)
+}
+
+// CurrentPriceNum returns CurrentPrice as a decimal. An empty CurrentPrice is zero.
+func (f FcmPosition) CurrentPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("current_price", f.CurrentPrice)
+}
+
+// AvgEntryPriceNum returns AvgEntryPrice as a decimal. An empty AvgEntryPrice is zero.
+func (f FcmPosition) AvgEntryPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("avg_entry_price", f.AvgEntryPrice)
+}
+
+// CfmRiskLimitNum returns CfmRiskLimit as a decimal. An empty CfmRiskLimit is zero.
+func (f FcmRiskLimits) CfmRiskLimitNum() (decimal.Decimal, error) {
+	return parseDecimal("cfm_risk_limit", f.CfmRiskLimit)
+}
+
+// CfmRiskLimitUtilizationNum returns CfmRiskLimitUtilization as a decimal. An empty CfmRiskLimitUtilization is zero.
+func (f FcmRiskLimits) CfmRiskLimitUtilizationNum() (decimal.Decimal, error) {
+	return parseDecimal("cfm_risk_limit_utilization", f.CfmRiskLimitUtilization)
+}
+
+// CfmTotalMarginNum returns CfmTotalMargin as a decimal. An empty CfmTotalMargin is zero.
+func (f FcmRiskLimits) CfmTotalMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("cfm_total_margin", f.CfmTotalMargin)
+}
+
+// CfmDeltaOteNum returns CfmDeltaOte as a decimal. An empty CfmDeltaOte is zero.
+func (f FcmRiskLimits) CfmDeltaOteNum() (decimal.Decimal, error) {
+	return parseDecimal("cfm_delta_ote", f.CfmDeltaOte)
+}
+
+// CfmUnsettledRealizedPnlNum returns CfmUnsettledRealizedPnl as a decimal. An empty CfmUnsettledRealizedPnl is zero.
+func (f FcmRiskLimits) CfmUnsettledRealizedPnlNum() (decimal.Decimal, error) {
+	return parseDecimal("cfm_unsettled_realized_pnl", f.CfmUnsettledRealizedPnl)
+}
+
+// CfmUnsettledAccruedFundingPnlNum returns CfmUnsettledAccruedFundingPnl as a decimal. An empty CfmUnsettledAccruedFundingPnl is zero.
+func (f FcmRiskLimits) CfmUnsettledAccruedFundingPnlNum() (decimal.Decimal, error) {
+	return parseDecimal("cfm_unsettled_accrued_funding_pnl", f.CfmUnsettledAccruedFundingPnl)
+}
+
+// TargetDerivativesExcessNum returns TargetDerivativesExcess as a decimal. An empty TargetDerivativesExcess is zero.
+func (f FcmSettings) TargetDerivativesExcessNum() (decimal.Decimal, error) {
+	return parseDecimal("target_derivatives_excess", f.TargetDerivativesExcess)
+}
+
+// SettlementPriceNum returns SettlementPrice as a decimal. An empty SettlementPrice is zero.
+func (f FcmTradingSessionDetails) SettlementPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("settlement_price", f.SettlementPrice)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (f FundMovement) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", f.Amount)
+}
+
+// ContractSizeNum returns ContractSize as a decimal. An empty ContractSize is zero.
+func (f FutureProductDetails) ContractSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("contract_size", f.ContractSize)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (l LoanInfo) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", l.Amount)
+}
+
+// NotionalAmountNum returns NotionalAmount as a decimal. An empty NotionalAmount is zero.
+func (l LoanInfo) NotionalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("notional_amount", l.NotionalAmount)
+}
+
+// RequestedAmountNum returns RequestedAmount as a decimal. An empty RequestedAmount is zero.
+func (l Locate) RequestedAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("requested_amount", l.RequestedAmount)
+}
+
+// InterestRateNum returns InterestRate as a decimal. An empty InterestRate is zero.
+func (l Locate) InterestRateNum() (decimal.Decimal, error) {
+	return parseDecimal("interest_rate", l.InterestRate)
+}
+
+// ApprovedAmountNum returns ApprovedAmount as a decimal. An empty ApprovedAmount is zero.
+func (l Locate) ApprovedAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("approved_amount", l.ApprovedAmount)
+}
+
+// QuantityNum returns Quantity as a decimal. An empty Quantity is zero.
+func (l LocateAvailability) QuantityNum() (decimal.Decimal, error) {
+	return parseDecimal("quantity", l.Quantity)
+}
+
+// RateNum returns Rate as a decimal. An empty Rate is zero.
+func (l LocateAvailability) RateNum() (decimal.Decimal, error) {
+	return parseDecimal("rate", l.Rate)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (m MarginAddOn) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", m.Amount)
+}
+
+// InitialNotionalAmountNum returns InitialNotionalAmount as a decimal. An empty InitialNotionalAmount is zero.
+func (m MarginCallRecord) InitialNotionalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("initial_notional_amount", m.InitialNotionalAmount)
+}
+
+// OutstandingNotionalAmountNum returns OutstandingNotionalAmount as a decimal. An empty OutstandingNotionalAmount is zero.
+func (m MarginCallRecord) OutstandingNotionalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("outstanding_notional_amount", m.OutstandingNotionalAmount)
+}
+
+// MarginEquityNum returns MarginEquity as a decimal. An empty MarginEquity is zero.
+func (m MarginSummary) MarginEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("margin_equity", m.MarginEquity)
+}
+
+// MarginRequirementNum returns MarginRequirement as a decimal. An empty MarginRequirement is zero.
+func (m MarginSummary) MarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("margin_requirement", m.MarginRequirement)
+}
+
+// ExcessDeficitNum returns ExcessDeficit as a decimal. An empty ExcessDeficit is zero.
+func (m MarginSummary) ExcessDeficitNum() (decimal.Decimal, error) {
+	return parseDecimal("excess_deficit", m.ExcessDeficit)
+}
+
+// PmCreditConsumedNum returns PmCreditConsumed as a decimal. An empty PmCreditConsumed is zero.
+func (m MarginSummary) PmCreditConsumedNum() (decimal.Decimal, error) {
+	return parseDecimal("pm_credit_consumed", m.PmCreditConsumed)
+}
+
+// TfCreditLimitNum returns TfCreditLimit as a decimal. An empty TfCreditLimit is zero.
+func (m MarginSummary) TfCreditLimitNum() (decimal.Decimal, error) {
+	return parseDecimal("tf_credit_limit", m.TfCreditLimit)
+}
+
+// TfCreditConsumedNum returns TfCreditConsumed as a decimal. An empty TfCreditConsumed is zero.
+func (m MarginSummary) TfCreditConsumedNum() (decimal.Decimal, error) {
+	return parseDecimal("tf_credit_consumed", m.TfCreditConsumed)
+}
+
+// TfAdjustedAssetValueNum returns TfAdjustedAssetValue as a decimal. An empty TfAdjustedAssetValue is zero.
+func (m MarginSummary) TfAdjustedAssetValueNum() (decimal.Decimal, error) {
+	return parseDecimal("tf_adjusted_asset_value", m.TfAdjustedAssetValue)
+}
+
+// TfAdjustedLiabilityValueNum returns TfAdjustedLiabilityValue as a decimal. An empty TfAdjustedLiabilityValue is zero.
+func (m MarginSummary) TfAdjustedLiabilityValueNum() (decimal.Decimal, error) {
+	return parseDecimal("tf_adjusted_liability_value", m.TfAdjustedLiabilityValue)
+}
+
+// TfAdjustedCreditConsumedNum returns TfAdjustedCreditConsumed as a decimal. An empty TfAdjustedCreditConsumed is zero.
+func (m MarginSummary) TfAdjustedCreditConsumedNum() (decimal.Decimal, error) {
+	return parseDecimal("tf_adjusted_credit_consumed", m.TfAdjustedCreditConsumed)
+}
+
+// TfAdjustedEquityNum returns TfAdjustedEquity as a decimal. An empty TfAdjustedEquity is zero.
+func (m MarginSummary) TfAdjustedEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("tf_adjusted_equity", m.TfAdjustedEquity)
+}
+
+// GrossMarketValueNum returns GrossMarketValue as a decimal. An empty GrossMarketValue is zero.
+func (m MarginSummary) GrossMarketValueNum() (decimal.Decimal, error) {
+	return parseDecimal("gross_market_value", m.GrossMarketValue)
+}
+
+// NetMarketValueNum returns NetMarketValue as a decimal. An empty NetMarketValue is zero.
+func (m MarginSummary) NetMarketValueNum() (decimal.Decimal, error) {
+	return parseDecimal("net_market_value", m.NetMarketValue)
+}
+
+// LongMarketValueNum returns LongMarketValue as a decimal. An empty LongMarketValue is zero.
+func (m MarginSummary) LongMarketValueNum() (decimal.Decimal, error) {
+	return parseDecimal("long_market_value", m.LongMarketValue)
+}
+
+// NonMarginableLongMarketValueNum returns NonMarginableLongMarketValue as a decimal. An empty NonMarginableLongMarketValue is zero.
+func (m MarginSummary) NonMarginableLongMarketValueNum() (decimal.Decimal, error) {
+	return parseDecimal("non_marginable_long_market_value", m.NonMarginableLongMarketValue)
+}
+
+// ShortMarketValueNum returns ShortMarketValue as a decimal. An empty ShortMarketValue is zero.
+func (m MarginSummary) ShortMarketValueNum() (decimal.Decimal, error) {
+	return parseDecimal("short_market_value", m.ShortMarketValue)
+}
+
+// GrossLeverageNum returns GrossLeverage as a decimal. An empty GrossLeverage is zero.
+func (m MarginSummary) GrossLeverageNum() (decimal.Decimal, error) {
+	return parseDecimal("gross_leverage", m.GrossLeverage)
+}
+
+// NetExposureNum returns NetExposure as a decimal. An empty NetExposure is zero.
+func (m MarginSummary) NetExposureNum() (decimal.Decimal, error) {
+	return parseDecimal("net_exposure", m.NetExposure)
+}
+
+// PmCreditLimitNum returns PmCreditLimit as a decimal. An empty PmCreditLimit is zero.
+func (m MarginSummary) PmCreditLimitNum() (decimal.Decimal, error) {
+	return parseDecimal("pm_credit_limit", m.PmCreditLimit)
+}
+
+// PmMarginLimitNum returns PmMarginLimit as a decimal. An empty PmMarginLimit is zero.
+func (m MarginSummary) PmMarginLimitNum() (decimal.Decimal, error) {
+	return parseDecimal("pm_margin_limit", m.PmMarginLimit)
+}
+
+// PmMarginConsumedNum returns PmMarginConsumed as a decimal. An empty PmMarginConsumed is zero.
+func (m MarginSummary) PmMarginConsumedNum() (decimal.Decimal, error) {
+	return parseDecimal("pm_margin_consumed", m.PmMarginConsumed)
+}
+
+// Vol5dNum returns Vol5d as a decimal. An empty Vol5d is zero.
+func (m MarketData) Vol5dNum() (decimal.Decimal, error) {
+	return parseDecimal("vol_5d", m.Vol5d)
+}
+
+// Vol30dNum returns Vol30d as a decimal. An empty Vol30d is zero.
+func (m MarketData) Vol30dNum() (decimal.Decimal, error) {
+	return parseDecimal("vol_30d", m.Vol30d)
+}
+
+// Vol90dNum returns Vol90d as a decimal. An empty Vol90d is zero.
+func (m MarketData) Vol90dNum() (decimal.Decimal, error) {
+	return parseDecimal("vol_90d", m.Vol90d)
+}
+
+// Adv30dNum returns Adv30d as a decimal. An empty Adv30d is zero.
+func (m MarketData) Adv30dNum() (decimal.Decimal, error) {
+	return parseDecimal("adv_30d", m.Adv30d)
+}
+
+// WeightedVolNum returns WeightedVol as a decimal. An empty WeightedVol is zero.
+func (m MarketData) WeightedVolNum() (decimal.Decimal, error) {
+	return parseDecimal("weighted_vol", m.WeightedVol)
+}
+
+// RateNum returns Rate as a decimal. An empty Rate is zero.
+func (m MarketRate) RateNum() (decimal.Decimal, error) {
+	return parseDecimal("rate", m.Rate)
+}
+
+// MinWithdrawalAmountNum returns MinWithdrawalAmount as a decimal. An empty MinWithdrawalAmount is zero.
+func (n Network) MinWithdrawalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("min_withdrawal_amount", n.MinWithdrawalAmount)
+}
+
+// MaxWithdrawalAmountNum returns MaxWithdrawalAmount as a decimal. An empty MaxWithdrawalAmount is zero.
+func (n Network) MaxWithdrawalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("max_withdrawal_amount", n.MaxWithdrawalAmount)
+}
+
+// MinDepositAmountNum returns MinDepositAmount as a decimal. An empty MinDepositAmount is zero.
+func (n Network) MinDepositAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("min_deposit_amount", n.MinDepositAmount)
+}
+
+// BaseQuantityNum returns BaseQuantity as a decimal. An empty BaseQuantity is zero.
+func (o Order) BaseQuantityNum() (decimal.Decimal, error) {
+	return parseDecimal("base_quantity", o.BaseQuantity)
+}
+
+// QuoteValueNum returns QuoteValue as a decimal. An empty QuoteValue is zero.
+func (o Order) QuoteValueNum() (decimal.Decimal, error) {
+	return parseDecimal("quote_value", o.QuoteValue)
+}
+
+// LimitPriceNum returns LimitPrice as a decimal. An empty LimitPrice is zero.
+func (o Order) LimitPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("limit_price", o.LimitPrice)
+}
+
+// DisplayQuoteSizeNum returns DisplayQuoteSize as a decimal. An empty DisplayQuoteSize is zero.
+func (o Order) DisplayQuoteSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("display_quote_size", o.DisplayQuoteSize)
+}
+
+// DisplayBaseSizeNum returns DisplayBaseSize as a decimal. An empty DisplayBaseSize is zero.
+func (o Order) DisplayBaseSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("display_base_size", o.DisplayBaseSize)
+}
+
+// FilledQuantityNum returns FilledQuantity as a decimal. An empty FilledQuantity is zero.
+func (o Order) FilledQuantityNum() (decimal.Decimal, error) {
+	return parseDecimal("filled_quantity", o.FilledQuantity)
+}
+
+// FilledValueNum returns FilledValue as a decimal. An empty FilledValue is zero.
+func (o Order) FilledValueNum() (decimal.Decimal, error) {
+	return parseDecimal("filled_value", o.FilledValue)
+}
+
+// AverageFilledPriceNum returns AverageFilledPrice as a decimal. An empty AverageFilledPrice is zero.
+func (o Order) AverageFilledPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("average_filled_price", o.AverageFilledPrice)
+}
+
+// CommissionNum returns Commission as a decimal. An empty Commission is zero.
+func (o Order) CommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("commission", o.Commission)
+}
+
+// ExchangeFeeNum returns ExchangeFee as a decimal. An empty ExchangeFee is zero.
+func (o Order) ExchangeFeeNum() (decimal.Decimal, error) {
+	return parseDecimal("exchange_fee", o.ExchangeFee)
+}
+
+// TotalNum returns Total as a decimal. An empty Total is zero.
+func (o Order) TotalNum() (decimal.Decimal, error) {
+	return parseDecimal("order_total", o.Total)
+}
+
+// BestBidNum returns BestBid as a decimal. An empty BestBid is zero.
+func (o Order) BestBidNum() (decimal.Decimal, error) {
+	return parseDecimal("best_bid", o.BestBid)
+}
+
+// BestAskNum returns BestAsk as a decimal. An empty BestAsk is zero.
+func (o Order) BestAskNum() (decimal.Decimal, error) {
+	return parseDecimal("best_ask", o.BestAsk)
+}
+
+// SlippageNum returns Slippage as a decimal. An empty Slippage is zero.
+func (o Order) SlippageNum() (decimal.Decimal, error) {
+	return parseDecimal("slippage", o.Slippage)
+}
+
+// HistoricalPovNum returns HistoricalPov as a decimal. An empty HistoricalPov is zero.
+func (o Order) HistoricalPovNum() (decimal.Decimal, error) {
+	return parseDecimal("historical_pov", o.HistoricalPov)
+}
+
+// StopPriceNum returns StopPrice as a decimal. An empty StopPrice is zero.
+func (o Order) StopPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("stop_price", o.StopPrice)
+}
+
+// NetAverageFilledPriceNum returns NetAverageFilledPrice as a decimal. An empty NetAverageFilledPrice is zero.
+func (o Order) NetAverageFilledPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("net_average_filled_price", o.NetAverageFilledPrice)
+}
+
+// DisplaySizeNum returns DisplaySize as a decimal. An empty DisplaySize is zero.
+func (o Order) DisplaySizeNum() (decimal.Decimal, error) {
+	return parseDecimal("display_size", o.DisplaySize)
+}
+
+// OffsetNum returns Offset as a decimal. An empty Offset is zero.
+func (o Order) OffsetNum() (decimal.Decimal, error) {
+	return parseDecimal("offset", o.Offset)
+}
+
+// WigLevelNum returns WigLevel as a decimal. An empty WigLevel is zero.
+func (o Order) WigLevelNum() (decimal.Decimal, error) {
+	return parseDecimal("wig_level", o.WigLevel)
+}
+
+// PriceNum returns Price as a decimal. An empty Price is zero.
+func (o OrderEditHistory) PriceNum() (decimal.Decimal, error) {
+	return parseDecimal("price", o.Price)
+}
+
+// SizeNum returns Size as a decimal. An empty Size is zero.
+func (o OrderEditHistory) SizeNum() (decimal.Decimal, error) {
+	return parseDecimal("size", o.Size)
+}
+
+// DisplaySizeNum returns DisplaySize as a decimal. An empty DisplaySize is zero.
+func (o OrderEditHistory) DisplaySizeNum() (decimal.Decimal, error) {
+	return parseDecimal("display_size", o.DisplaySize)
+}
+
+// StopPriceNum returns StopPrice as a decimal. An empty StopPrice is zero.
+func (o OrderEditHistory) StopPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("stop_price", o.StopPrice)
+}
+
+// StopLimitPriceNum returns StopLimitPrice as a decimal. An empty StopLimitPrice is zero.
+func (o OrderEditHistory) StopLimitPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("stop_limit_price", o.StopLimitPrice)
+}
+
+// FilledQuantityNum returns FilledQuantity as a decimal. An empty FilledQuantity is zero.
+func (o OrderFill) FilledQuantityNum() (decimal.Decimal, error) {
+	return parseDecimal("filled_quantity", o.FilledQuantity)
+}
+
+// FilledValueNum returns FilledValue as a decimal. An empty FilledValue is zero.
+func (o OrderFill) FilledValueNum() (decimal.Decimal, error) {
+	return parseDecimal("filled_value", o.FilledValue)
+}
+
+// PriceNum returns Price as a decimal. An empty Price is zero.
+func (o OrderFill) PriceNum() (decimal.Decimal, error) {
+	return parseDecimal("price", o.Price)
+}
+
+// CommissionNum returns Commission as a decimal. An empty Commission is zero.
+func (o OrderFill) CommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("commission", o.Commission)
+}
+
+// VenueFeesNum returns VenueFees as a decimal. An empty VenueFees is zero.
+func (o OrderFill) VenueFeesNum() (decimal.Decimal, error) {
+	return parseDecimal("venue_fees", o.VenueFees)
+}
+
+// CesCommissionNum returns CesCommission as a decimal. An empty CesCommission is zero.
+func (o OrderFill) CesCommissionNum() (decimal.Decimal, error) {
+	return parseDecimal("ces_commission", o.CesCommission)
+}
+
+// OpenInterestNum returns OpenInterest as a decimal. An empty OpenInterest is zero.
+func (p PerpetualProductDetails) OpenInterestNum() (decimal.Decimal, error) {
+	return parseDecimal("open_interest", p.OpenInterest)
+}
+
+// FundingRateNum returns FundingRate as a decimal. An empty FundingRate is zero.
+func (p PerpetualProductDetails) FundingRateNum() (decimal.Decimal, error) {
+	return parseDecimal("funding_rate", p.FundingRate)
+}
+
+// MaxLeverageNum returns MaxLeverage as a decimal. An empty MaxLeverage is zero.
+func (p PerpetualProductDetails) MaxLeverageNum() (decimal.Decimal, error) {
+	return parseDecimal("max_leverage", p.MaxLeverage)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (p PmAssetInfo) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", p.Amount)
+}
+
+// PriceNum returns Price as a decimal. An empty Price is zero.
+func (p PmAssetInfo) PriceNum() (decimal.Decimal, error) {
+	return parseDecimal("price", p.Price)
+}
+
+// NotionalAmountNum returns NotionalAmount as a decimal. An empty NotionalAmount is zero.
+func (p PmAssetInfo) NotionalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("notional_amount", p.NotionalAmount)
+}
+
+// BaseMarginRequirementNum returns BaseMarginRequirement as a decimal. An empty BaseMarginRequirement is zero.
+func (p PmAssetInfo) BaseMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("base_margin_requirement", p.BaseMarginRequirement)
+}
+
+// BaseMarginRequirementNotionalNum returns BaseMarginRequirementNotional as a decimal. An empty BaseMarginRequirementNotional is zero.
+func (p PmAssetInfo) BaseMarginRequirementNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("base_margin_requirement_notional", p.BaseMarginRequirementNotional)
+}
+
+// Adv30dNum returns Adv30d as a decimal. An empty Adv30d is zero.
+func (p PmAssetInfo) Adv30dNum() (decimal.Decimal, error) {
+	return parseDecimal("adv_30d", p.Adv30d)
+}
+
+// Hist5dVolNum returns Hist5dVol as a decimal. An empty Hist5dVol is zero.
+func (p PmAssetInfo) Hist5dVolNum() (decimal.Decimal, error) {
+	return parseDecimal("hist_5d_vol", p.Hist5dVol)
+}
+
+// Hist30dVolNum returns Hist30dVol as a decimal. An empty Hist30dVol is zero.
+func (p PmAssetInfo) Hist30dVolNum() (decimal.Decimal, error) {
+	return parseDecimal("hist_30d_vol", p.Hist30dVol)
+}
+
+// Hist90dVolNum returns Hist90dVol as a decimal. An empty Hist90dVol is zero.
+func (p PmAssetInfo) Hist90dVolNum() (decimal.Decimal, error) {
+	return parseDecimal("hist_90d_vol", p.Hist90dVol)
+}
+
+// VolatilityAddonNum returns VolatilityAddon as a decimal. An empty VolatilityAddon is zero.
+func (p PmAssetInfo) VolatilityAddonNum() (decimal.Decimal, error) {
+	return parseDecimal("volatility_addon", p.VolatilityAddon)
+}
+
+// LiquidityAddonNum returns LiquidityAddon as a decimal. An empty LiquidityAddon is zero.
+func (p PmAssetInfo) LiquidityAddonNum() (decimal.Decimal, error) {
+	return parseDecimal("liquidity_addon", p.LiquidityAddon)
+}
+
+// TotalPositionMarginNum returns TotalPositionMargin as a decimal. An empty TotalPositionMargin is zero.
+func (p PmAssetInfo) TotalPositionMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("total_position_margin", p.TotalPositionMargin)
+}
+
+// ShortNominalNum returns ShortNominal as a decimal. An empty ShortNominal is zero.
+func (p PmAssetInfo) ShortNominalNum() (decimal.Decimal, error) {
+	return parseDecimal("short_nominal", p.ShortNominal)
+}
+
+// LongNominalNum returns LongNominal as a decimal. An empty LongNominal is zero.
+func (p PmAssetInfo) LongNominalNum() (decimal.Decimal, error) {
+	return parseDecimal("long_nominal", p.LongNominal)
+}
+
+// LimitNum returns Limit as a decimal. An empty Limit is zero.
+func (p PostTradeCredit) LimitNum() (decimal.Decimal, error) {
+	return parseDecimal("limit", p.Limit)
+}
+
+// UtilizedNum returns Utilized as a decimal. An empty Utilized is zero.
+func (p PostTradeCredit) UtilizedNum() (decimal.Decimal, error) {
+	return parseDecimal("utilized", p.Utilized)
+}
+
+// AvailableNum returns Available as a decimal. An empty Available is zero.
+func (p PostTradeCredit) AvailableNum() (decimal.Decimal, error) {
+	return parseDecimal("available", p.Available)
+}
+
+// AdjustedCreditUtilizedNum returns AdjustedCreditUtilized as a decimal. An empty AdjustedCreditUtilized is zero.
+func (p PostTradeCredit) AdjustedCreditUtilizedNum() (decimal.Decimal, error) {
+	return parseDecimal("adjusted_credit_utilized", p.AdjustedCreditUtilized)
+}
+
+// AdjustedEquityNum returns AdjustedEquity as a decimal. An empty AdjustedEquity is zero.
+func (p PostTradeCredit) AdjustedEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("adjusted_portfolio_equity", p.AdjustedEquity)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (p PostTradeCreditAmountDue) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", p.Amount)
+}
+
+// LimitNum returns Limit as a decimal. An empty Limit is zero.
+func (p PostTradeCreditInfo) LimitNum() (decimal.Decimal, error) {
+	return parseDecimal("limit", p.Limit)
+}
+
+// UtilizedNum returns Utilized as a decimal. An empty Utilized is zero.
+func (p PostTradeCreditInfo) UtilizedNum() (decimal.Decimal, error) {
+	return parseDecimal("utilized", p.Utilized)
+}
+
+// AvailableNum returns Available as a decimal. An empty Available is zero.
+func (p PostTradeCreditInfo) AvailableNum() (decimal.Decimal, error) {
+	return parseDecimal("available", p.Available)
+}
+
+// AdjustedCreditUtilizedNum returns AdjustedCreditUtilized as a decimal. An empty AdjustedCreditUtilized is zero.
+func (p PostTradeCreditInfo) AdjustedCreditUtilizedNum() (decimal.Decimal, error) {
+	return parseDecimal("adjusted_credit_utilized", p.AdjustedCreditUtilized)
+}
+
+// AdjustedPortfolioEquityNum returns AdjustedPortfolioEquity as a decimal. An empty AdjustedPortfolioEquity is zero.
+func (p PostTradeCreditInfo) AdjustedPortfolioEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("adjusted_portfolio_equity", p.AdjustedPortfolioEquity)
+}
+
+// DeficitThresholdNum returns DeficitThreshold as a decimal. An empty DeficitThreshold is zero.
+func (p PrimeXMMarginCallThresholds) DeficitThresholdNum() (decimal.Decimal, error) {
+	return parseDecimal("deficit_threshold", p.DeficitThreshold)
+}
+
+// WarningThresholdNum returns WarningThreshold as a decimal. An empty WarningThreshold is zero.
+func (p PrimeXMMarginCallThresholds) WarningThresholdNum() (decimal.Decimal, error) {
+	return parseDecimal("warning_threshold", p.WarningThreshold)
+}
+
+// CriticalThresholdNum returns CriticalThreshold as a decimal. An empty CriticalThreshold is zero.
+func (p PrimeXMMarginCallThresholds) CriticalThresholdNum() (decimal.Decimal, error) {
+	return parseDecimal("critical_threshold", p.CriticalThreshold)
+}
+
+// LiquidationThresholdNum returns LiquidationThreshold as a decimal. An empty LiquidationThreshold is zero.
+func (p PrimeXMMarginCallThresholds) LiquidationThresholdNum() (decimal.Decimal, error) {
+	return parseDecimal("liquidation_threshold", p.LiquidationThreshold)
+}
+
+// BaseMarginNum returns BaseMargin as a decimal. An empty BaseMargin is zero.
+func (p PrimeXMMarginRequirementBreakdown) BaseMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("base_margin", p.BaseMargin)
+}
+
+// VolatilityAddonNum returns VolatilityAddon as a decimal. An empty VolatilityAddon is zero.
+func (p PrimeXMMarginRequirementBreakdown) VolatilityAddonNum() (decimal.Decimal, error) {
+	return parseDecimal("volatility_addon", p.VolatilityAddon)
+}
+
+// LiquidityAddonNum returns LiquidityAddon as a decimal. An empty LiquidityAddon is zero.
+func (p PrimeXMMarginRequirementBreakdown) LiquidityAddonNum() (decimal.Decimal, error) {
+	return parseDecimal("liquidity_addon", p.LiquidityAddon)
+}
+
+// OffsetCreditNum returns OffsetCredit as a decimal. An empty OffsetCredit is zero.
+func (p PrimeXMMarginRequirementBreakdown) OffsetCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("offset_credit", p.OffsetCredit)
+}
+
+// FuturesMarginNum returns FuturesMargin as a decimal. An empty FuturesMargin is zero.
+func (p PrimeXMMarginRequirementBreakdown) FuturesMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_margin", p.FuturesMargin)
+}
+
+// ThresholdValueNum returns ThresholdValue as a decimal. An empty ThresholdValue is zero.
+func (p PrimeXMMarginThreshold) ThresholdValueNum() (decimal.Decimal, error) {
+	return parseDecimal("threshold_value", p.ThresholdValue)
+}
+
+// BasisCreditNum returns BasisCredit as a decimal. An empty BasisCredit is zero.
+func (p PrimeXMOffsetCreditBreakdown) BasisCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("basis_credit", p.BasisCredit)
+}
+
+// LongShortCreditNum returns LongShortCredit as a decimal. An empty LongShortCredit is zero.
+func (p PrimeXMOffsetCreditBreakdown) LongShortCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("long_short_credit", p.LongShortCredit)
+}
+
+// LongLongCreditNum returns LongLongCredit as a decimal. An empty LongLongCredit is zero.
+func (p PrimeXMOffsetCreditBreakdown) LongLongCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("long_long_credit", p.LongLongCredit)
+}
+
+// ShortShortCreditNum returns ShortShortCredit as a decimal. An empty ShortShortCredit is zero.
+func (p PrimeXMOffsetCreditBreakdown) ShortShortCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("short_short_credit", p.ShortShortCredit)
+}
+
+// SameTierCreditNum returns SameTierCredit as a decimal. An empty SameTierCredit is zero.
+func (p PrimeXMOffsetCreditBreakdown) SameTierCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("same_tier_credit", p.SameTierCredit)
+}
+
+// TotalCreditNum returns TotalCredit as a decimal. An empty TotalCredit is zero.
+func (p PrimeXMOffsetCreditBreakdown) TotalCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("total_credit", p.TotalCredit)
+}
+
+// BaseIncrementNum returns BaseIncrement as a decimal. An empty BaseIncrement is zero.
+func (p Product) BaseIncrementNum() (decimal.Decimal, error) {
+	return parseDecimal("base_increment", p.BaseIncrement)
+}
+
+// QuoteIncrementNum returns QuoteIncrement as a decimal. An empty QuoteIncrement is zero.
+func (p Product) QuoteIncrementNum() (decimal.Decimal, error) {
+	return parseDecimal("quote_increment", p.QuoteIncrement)
+}
+
+// BaseMinSizeNum returns BaseMinSize as a decimal. An empty BaseMinSize is zero.
+func (p Product) BaseMinSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("base_min_size", p.BaseMinSize)
+}
+
+// BaseMaxSizeNum returns BaseMaxSize as a decimal. An empty BaseMaxSize is zero.
+func (p Product) BaseMaxSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("base_max_size", p.BaseMaxSize)
+}
+
+// QuoteMinSizeNum returns QuoteMinSize as a decimal. An empty QuoteMinSize is zero.
+func (p Product) QuoteMinSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("quote_min_size", p.QuoteMinSize)
+}
+
+// QuoteMaxSizeNum returns QuoteMaxSize as a decimal. An empty QuoteMaxSize is zero.
+func (p Product) QuoteMaxSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("quote_max_size", p.QuoteMaxSize)
+}
+
+// PriceIncrementNum returns PriceIncrement as a decimal. An empty PriceIncrement is zero.
+func (p Product) PriceIncrementNum() (decimal.Decimal, error) {
+	return parseDecimal("price_increment", p.PriceIncrement)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (r RequestedAmount) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", r.Amount)
+}
+
+// MinBaseSizeNum returns MinBaseSize as a decimal. An empty MinBaseSize is zero.
+func (r RfqProductDetails) MinBaseSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("min_base_size", r.MinBaseSize)
+}
+
+// MaxBaseSizeNum returns MaxBaseSize as a decimal. An empty MaxBaseSize is zero.
+func (r RfqProductDetails) MaxBaseSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("max_base_size", r.MaxBaseSize)
+}
+
+// MinQuoteSizeNum returns MinQuoteSize as a decimal. An empty MinQuoteSize is zero.
+func (r RfqProductDetails) MinQuoteSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("min_quote_size", r.MinQuoteSize)
+}
+
+// MaxQuoteSizeNum returns MaxQuoteSize as a decimal. An empty MaxQuoteSize is zero.
+func (r RfqProductDetails) MaxQuoteSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("max_quote_size", r.MaxQuoteSize)
+}
+
+// MinNotionalSizeNum returns MinNotionalSize as a decimal. An empty MinNotionalSize is zero.
+func (r RfqProductDetails) MinNotionalSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("min_notional_size", r.MinNotionalSize)
+}
+
+// MaxNotionalSizeNum returns MaxNotionalSize as a decimal. An empty MaxNotionalSize is zero.
+func (r RfqProductDetails) MaxNotionalSizeNum() (decimal.Decimal, error) {
+	return parseDecimal("max_notional_size", r.MaxNotionalSize)
+}
+
+// OldBalanceNum returns OldBalance as a decimal. An empty OldBalance is zero.
+func (s ShortCollateral) OldBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("old_balance", s.OldBalance)
+}
+
+// NewBalanceNum returns NewBalance as a decimal. An empty NewBalance is zero.
+func (s ShortCollateral) NewBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("new_balance", s.NewBalance)
+}
+
+// LoanInterestRateNum returns LoanInterestRate as a decimal. An empty LoanInterestRate is zero.
+func (s ShortCollateral) LoanInterestRateNum() (decimal.Decimal, error) {
+	return parseDecimal("loan_interest_rate", s.LoanInterestRate)
+}
+
+// CollateralInterestRateNum returns CollateralInterestRate as a decimal. An empty CollateralInterestRate is zero.
+func (s ShortCollateral) CollateralInterestRateNum() (decimal.Decimal, error) {
+	return parseDecimal("collateral_interest_rate", s.CollateralInterestRate)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (s StakingStatus) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", s.Amount)
+}
+
+// AssetAdjustmentNum returns AssetAdjustment as a decimal. An empty AssetAdjustment is zero.
+func (t TFAsset) AssetAdjustmentNum() (decimal.Decimal, error) {
+	return parseDecimal("asset_adjustment", t.AssetAdjustment)
+}
+
+// LiabilityAdjustmentNum returns LiabilityAdjustment as a decimal. An empty LiabilityAdjustment is zero.
+func (t TFAsset) LiabilityAdjustmentNum() (decimal.Decimal, error) {
+	return parseDecimal("liability_adjustment", t.LiabilityAdjustment)
+}
+
+// RateNum returns Rate as a decimal. An empty Rate is zero.
+func (t TierPairRateEntry) RateNum() (decimal.Decimal, error) {
+	return parseDecimal("rate", t.Rate)
+}
+
+// FeeNum returns Fee as a decimal. An empty Fee is zero.
+func (t TieredPricingFee) FeeNum() (decimal.Decimal, error) {
+	return parseDecimal("fee", t.Fee)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (t Transaction) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", t.Amount)
+}
+
+// NetworkFeesNum returns NetworkFees as a decimal. An empty NetworkFees is zero.
+func (t Transaction) NetworkFeesNum() (decimal.Decimal, error) {
+	return parseDecimal("network_fees", t.NetworkFees)
+}
+
+// FeesNum returns Fees as a decimal. An empty Fees is zero.
+func (t Transaction) FeesNum() (decimal.Decimal, error) {
+	return parseDecimal("fees", t.Fees)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (u UnstakeStatus) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", u.Amount)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (u UnstakingStatus) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", u.Amount)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (v ValidatorAllocation) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", v.Amount)
+}
+
+// EstimatedUnstakingAmountNum returns EstimatedUnstakingAmount as a decimal. An empty EstimatedUnstakingAmount is zero.
+func (v ValidatorUnstakePreview) EstimatedUnstakingAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("estimated_unstaking_amount", v.EstimatedUnstakingAmount)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (w Web3Balance) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", w.Amount)
+}
+
+// AmountNum returns Amount as a decimal. An empty Amount is zero.
+func (w WithdrawalPower) AmountNum() (decimal.Decimal, error) {
+	return parseDecimal("amount", w.Amount)
+}
+
+// PrincipalCurrencyMarketPriceNum returns PrincipalCurrencyMarketPrice as a decimal. An empty PrincipalCurrencyMarketPrice is zero.
+func (x XMLoan) PrincipalCurrencyMarketPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("principal_currency_market_price", x.PrincipalCurrencyMarketPrice)
+}
+
+// InitialPrincipalAmountNum returns InitialPrincipalAmount as a decimal. An empty InitialPrincipalAmount is zero.
+func (x XMLoan) InitialPrincipalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("initial_principal_amount", x.InitialPrincipalAmount)
+}
+
+// OutstandingPrincipalAmountNum returns OutstandingPrincipalAmount as a decimal. An empty OutstandingPrincipalAmount is zero.
+func (x XMLoan) OutstandingPrincipalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("outstanding_principal_amount", x.OutstandingPrincipalAmount)
+}
+
+// InitialNotionalAmountNum returns InitialNotionalAmount as a decimal. An empty InitialNotionalAmount is zero.
+func (x XMMarginCall) InitialNotionalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("initial_notional_amount", x.InitialNotionalAmount)
+}
+
+// OutstandingNotionalAmountNum returns OutstandingNotionalAmount as a decimal. An empty OutstandingNotionalAmount is zero.
+func (x XMMarginCall) OutstandingNotionalAmountNum() (decimal.Decimal, error) {
+	return parseDecimal("outstanding_notional_amount", x.OutstandingNotionalAmount)
+}
+
+// MarketPriceNum returns MarketPrice as a decimal. An empty MarketPrice is zero.
+func (x XMPosition) MarketPriceNum() (decimal.Decimal, error) {
+	return parseDecimal("market_price", x.MarketPrice)
+}
+
+// MarketCapNum returns MarketCap as a decimal. An empty MarketCap is zero.
+func (x XMPosition) MarketCapNum() (decimal.Decimal, error) {
+	return parseDecimal("market_cap", x.MarketCap)
+}
+
+// Adv30DaysNum returns Adv30Days as a decimal. An empty Adv30Days is zero.
+func (x XMPosition) Adv30DaysNum() (decimal.Decimal, error) {
+	return parseDecimal("adv30_days", x.Adv30Days)
+}
+
+// Hist5dVolNum returns Hist5dVol as a decimal. An empty Hist5dVol is zero.
+func (x XMPosition) Hist5dVolNum() (decimal.Decimal, error) {
+	return parseDecimal("hist5d_vol", x.Hist5dVol)
+}
+
+// Hist30dVolNum returns Hist30dVol as a decimal. An empty Hist30dVol is zero.
+func (x XMPosition) Hist30dVolNum() (decimal.Decimal, error) {
+	return parseDecimal("hist30d_vol", x.Hist30dVol)
+}
+
+// Hist90dVolNum returns Hist90dVol as a decimal. An empty Hist90dVol is zero.
+func (x XMPosition) Hist90dVolNum() (decimal.Decimal, error) {
+	return parseDecimal("hist90d_vol", x.Hist90dVol)
+}
+
+// MarginRequirementNum returns MarginRequirement as a decimal. An empty MarginRequirement is zero.
+func (x XMPosition) MarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("margin_requirement", x.MarginRequirement)
+}
+
+// SpotBalanceNum returns SpotBalance as a decimal. An empty SpotBalance is zero.
+func (x XMPosition) SpotBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("spot_balance", x.SpotBalance)
+}
+
+// SpotBalanceNotionalNum returns SpotBalanceNotional as a decimal. An empty SpotBalanceNotional is zero.
+func (x XMPosition) SpotBalanceNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("spot_balance_notional", x.SpotBalanceNotional)
+}
+
+// SpotTotalPositionMarginNum returns SpotTotalPositionMargin as a decimal. An empty SpotTotalPositionMargin is zero.
+func (x XMPosition) SpotTotalPositionMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("spot_total_position_margin", x.SpotTotalPositionMargin)
+}
+
+// FuturesBalanceNum returns FuturesBalance as a decimal. An empty FuturesBalance is zero.
+func (x XMPosition) FuturesBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_balance", x.FuturesBalance)
+}
+
+// FuturesBalanceNotionalNum returns FuturesBalanceNotional as a decimal. An empty FuturesBalanceNotional is zero.
+func (x XMPosition) FuturesBalanceNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_balance_notional", x.FuturesBalanceNotional)
+}
+
+// FuturesTotalPositionMarginNum returns FuturesTotalPositionMargin as a decimal. An empty FuturesTotalPositionMargin is zero.
+func (x XMPosition) FuturesTotalPositionMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_total_position_margin", x.FuturesTotalPositionMargin)
+}
+
+// GmvBasisNum returns GmvBasis as a decimal. An empty GmvBasis is zero.
+func (x XMPosition) GmvBasisNum() (decimal.Decimal, error) {
+	return parseDecimal("gmv_basis", x.GmvBasis)
+}
+
+// BaseRequirementNum returns BaseRequirement as a decimal. An empty BaseRequirement is zero.
+func (x XMPosition) BaseRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("base_requirement", x.BaseRequirement)
+}
+
+// LiqShortsAddOnNum returns LiqShortsAddOn as a decimal. An empty LiqShortsAddOn is zero.
+func (x XMPosition) LiqShortsAddOnNum() (decimal.Decimal, error) {
+	return parseDecimal("liq_shorts_add_on", x.LiqShortsAddOn)
+}
+
+// LiqLongsAddOnNum returns LiqLongsAddOn as a decimal. An empty LiqLongsAddOn is zero.
+func (x XMPosition) LiqLongsAddOnNum() (decimal.Decimal, error) {
+	return parseDecimal("liq_longs_add_on", x.LiqLongsAddOn)
+}
+
+// VolShortsAddOnNum returns VolShortsAddOn as a decimal. An empty VolShortsAddOn is zero.
+func (x XMPosition) VolShortsAddOnNum() (decimal.Decimal, error) {
+	return parseDecimal("vol_shorts_add_on", x.VolShortsAddOn)
+}
+
+// VolLongsAddOnNum returns VolLongsAddOn as a decimal. An empty VolLongsAddOn is zero.
+func (x XMPosition) VolLongsAddOnNum() (decimal.Decimal, error) {
+	return parseDecimal("vol_longs_add_on", x.VolLongsAddOn)
+}
+
+// Vol5daysAddOnNum returns Vol5daysAddOn as a decimal. An empty Vol5daysAddOn is zero.
+func (x XMPosition) Vol5daysAddOnNum() (decimal.Decimal, error) {
+	return parseDecimal("vol5days_add_on", x.Vol5daysAddOn)
+}
+
+// Vol30daysAddOnNum returns Vol30daysAddOn as a decimal. An empty Vol30daysAddOn is zero.
+func (x XMPosition) Vol30daysAddOnNum() (decimal.Decimal, error) {
+	return parseDecimal("vol30days_add_on", x.Vol30daysAddOn)
+}
+
+// Vol90daysAddOnNum returns Vol90daysAddOn as a decimal. An empty Vol90daysAddOn is zero.
+func (x XMPosition) Vol90daysAddOnNum() (decimal.Decimal, error) {
+	return parseDecimal("vol90days_add_on", x.Vol90daysAddOn)
+}
+
+// TotalPositionMarginNum returns TotalPositionMargin as a decimal. An empty TotalPositionMargin is zero.
+func (x XMPosition) TotalPositionMarginNum() (decimal.Decimal, error) {
+	return parseDecimal("total_position_margin", x.TotalPositionMargin)
+}
+
+// DcoMarginRequirementNum returns DcoMarginRequirement as a decimal. An empty DcoMarginRequirement is zero.
+func (x XMRiskNettingInfo) DcoMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("dco_margin_requirement", x.DcoMarginRequirement)
+}
+
+// PortfolioMarginRequirementNum returns PortfolioMarginRequirement as a decimal. An empty PortfolioMarginRequirement is zero.
+func (x XMRiskNettingInfo) PortfolioMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("portfolio_margin_requirement", x.PortfolioMarginRequirement)
+}
+
+// IntegratedPortfolioMarginRequirementNum returns IntegratedPortfolioMarginRequirement as a decimal. An empty IntegratedPortfolioMarginRequirement is zero.
+func (x XMRiskNettingInfo) IntegratedPortfolioMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("integrated_portfolio_margin_requirement", x.IntegratedPortfolioMarginRequirement)
+}
+
+// IneligibleFuturesMarginRequirementNum returns IneligibleFuturesMarginRequirement as a decimal. An empty IneligibleFuturesMarginRequirement is zero.
+func (x XMRiskNettingInfo) IneligibleFuturesMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("ineligible_futures_margin_requirement", x.IneligibleFuturesMarginRequirement)
+}
+
+// PositionMarginRequirementNum returns PositionMarginRequirement as a decimal. An empty PositionMarginRequirement is zero.
+func (x XMRiskNettingInfo) PositionMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("position_margin_requirement", x.PositionMarginRequirement)
+}
+
+// PortfolioMarginAddonNum returns PortfolioMarginAddon as a decimal. An empty PortfolioMarginAddon is zero.
+func (x XMRiskNettingInfo) PortfolioMarginAddonNum() (decimal.Decimal, error) {
+	return parseDecimal("portfolio_margin_addon", x.PortfolioMarginAddon)
+}
+
+// IntegratedPositionMarginRequirementNum returns IntegratedPositionMarginRequirement as a decimal. An empty IntegratedPositionMarginRequirement is zero.
+func (x XMRiskNettingInfo) IntegratedPositionMarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("integrated_position_margin_requirement", x.IntegratedPositionMarginRequirement)
+}
+
+// IntegratedPortfolioMarginAddonNum returns IntegratedPortfolioMarginAddon as a decimal. An empty IntegratedPortfolioMarginAddon is zero.
+func (x XMRiskNettingInfo) IntegratedPortfolioMarginAddonNum() (decimal.Decimal, error) {
+	return parseDecimal("integrated_portfolio_margin_addon", x.IntegratedPortfolioMarginAddon)
+}
+
+// NettedFuturesNotionalNum returns NettedFuturesNotional as a decimal. An empty NettedFuturesNotional is zero.
+func (x XMRiskNettingInfo) NettedFuturesNotionalNum() (decimal.Decimal, error) {
+	return parseDecimal("netted_futures_notional", x.NettedFuturesNotional)
+}
+
+// TotalGmvBasisNum returns TotalGmvBasis as a decimal. An empty TotalGmvBasis is zero.
+func (x XMRiskNettingInfo) TotalGmvBasisNum() (decimal.Decimal, error) {
+	return parseDecimal("total_gmv_basis", x.TotalGmvBasis)
+}
+
+// IpmCashBalanceNum returns IpmCashBalance as a decimal. An empty IpmCashBalance is zero.
+func (x XMRiskNettingInfo) IpmCashBalanceNum() (decimal.Decimal, error) {
+	return parseDecimal("ipm_cash_balance", x.IpmCashBalance)
+}
+
+// MarginRequirementNum returns MarginRequirement as a decimal. An empty MarginRequirement is zero.
+func (x XMSummary) MarginRequirementNum() (decimal.Decimal, error) {
+	return parseDecimal("margin_requirement", x.MarginRequirement)
+}
+
+// AccountEquityNum returns AccountEquity as a decimal. An empty AccountEquity is zero.
+func (x XMSummary) AccountEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("account_equity", x.AccountEquity)
+}
+
+// MarginExcessShortfallNum returns MarginExcessShortfall as a decimal. An empty MarginExcessShortfall is zero.
+func (x XMSummary) MarginExcessShortfallNum() (decimal.Decimal, error) {
+	return parseDecimal("margin_excess_shortfall", x.MarginExcessShortfall)
+}
+
+// ConsumedCreditNum returns ConsumedCredit as a decimal. An empty ConsumedCredit is zero.
+func (x XMSummary) ConsumedCreditNum() (decimal.Decimal, error) {
+	return parseDecimal("consumed_credit", x.ConsumedCredit)
+}
+
+// XMCreditLimitNum returns XMCreditLimit as a decimal. An empty XMCreditLimit is zero.
+func (x XMSummary) XMCreditLimitNum() (decimal.Decimal, error) {
+	return parseDecimal("xm_credit_limit", x.XMCreditLimit)
+}
+
+// XMMarginLimitNum returns XMMarginLimit as a decimal. An empty XMMarginLimit is zero.
+func (x XMSummary) XMMarginLimitNum() (decimal.Decimal, error) {
+	return parseDecimal("xm_margin_limit", x.XMMarginLimit)
+}
+
+// SpotEquityNum returns SpotEquity as a decimal. An empty SpotEquity is zero.
+func (x XMSummary) SpotEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("spot_equity", x.SpotEquity)
+}
+
+// FuturesEquityNum returns FuturesEquity as a decimal. An empty FuturesEquity is zero.
+func (x XMSummary) FuturesEquityNum() (decimal.Decimal, error) {
+	return parseDecimal("futures_equity", x.FuturesEquity)
+}
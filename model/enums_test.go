@@ -0,0 +1,76 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumsDecodeUnknownValues(t *testing.T) {
+	var o Order
+	if err := json.Unmarshal([]byte(`{"type":"ICEBERG","status":"PARKED","time_in_force":"GOOD_FOR_DAY"}`), &o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if o.Type != "ICEBERG" || o.Type.IsValid() || o.Status.IsValid() || o.Status.IsTerminal() || o.TimeInForce.IsValid() {
+		t.Fatalf("unexpected order enums: %+v", o)
+	}
+
+	var tx Transaction
+	if err := json.Unmarshal([]byte(`{"type":"WITHDRAWAL","status":"TRANSACTION_DONE"}`), &tx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Type != TransactionTypeWithdrawal || !tx.Status.IsValid() || !tx.Status.IsTerminal() {
+		t.Fatalf("unexpected transaction enums: %+v", tx)
+	}
+
+	var a Activity
+	if err := json.Unmarshal([]byte(`{"category":"ACTIVITY_CATEGORY_ORDER","status":"ACTIVITY_STATUS_PROCESSING"}`), &a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.Category != ActivityCategoryOrder || !a.Status.IsValid() || a.Status.IsTerminal() {
+		t.Fatalf("unexpected activity enums: %+v", a)
+	}
+}
+
+func TestIsTerminal(t *testing.T) {
+	for _, s := range []OrderStatus{OrderStatusFilled, OrderStatusCancelled, OrderStatusExpired, OrderStatusFailed} {
+		if !s.IsTerminal() {
+			t.Errorf("%s should be terminal", s)
+		}
+	}
+	for _, s := range []OrderStatus{OrderStatusOpen, OrderStatusPending} {
+		if s.IsTerminal() {
+			t.Errorf("%s should not be terminal", s)
+		}
+	}
+
+	for _, s := range []TransactionStatus{TransactionStatusCreated, TransactionStatusProcessing, TransactionStatusBroadcasting, TransactionStatusDelayed} {
+		if s.IsTerminal() {
+			t.Errorf("%s should not be terminal", s)
+		}
+	}
+	for _, s := range []TransactionStatus{TransactionStatusDone, TransactionStatusRejected, TransactionStatusExpired} {
+		if !s.IsTerminal() {
+			t.Errorf("%s should be terminal", s)
+		}
+	}
+
+	if ActivityStatusOther.IsTerminal() || !ActivityStatusCompleted.IsTerminal() {
+		t.Error("unexpected activity status terminality")
+	}
+}
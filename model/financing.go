@@ -36,10 +36,10 @@ type MarginCallRecord struct {
 	OutstandingNotionalAmount string `json:"outstanding_notional_amount"`
 
 	// The time the margin call is created in RFC3339 format
-	CreatedAt string `json:"created_at"`
+	CreatedAt Timestamp `json:"created_at"`
 
 	// The time the margin call is due in RFC3339 format
-	DueAt string `json:"due_at"`
+	DueAt Timestamp `json:"due_at"`
 }
 
 type LoanInfo struct {
@@ -56,7 +56,7 @@ type LoanInfo struct {
 	NotionalAmount string `json:"notional_amount"`
 
 	// Settlement due date
-	DueDate string `json:"due_date"`
+	DueDate Timestamp `json:"due_date"`
 }
 
 type MarginAddOnType string
@@ -277,10 +277,10 @@ type MarginInfo struct {
 
 type MarginSummaryHistorical struct {
 	// The UTC date time used for conversion
-	ConversionDatetime string `json:"conversion_datetime,omitempty"`
+	ConversionDatetime Timestamp `json:"conversion_datetime,omitzero"`
 
 	// The date used for conversion
-	ConversionDate string `json:"conversion_date,omitempty"`
+	ConversionDate Timestamp `json:"conversion_date,omitzero"`
 
 	// The margin summary
 	MarginSummary *MarginSummary `json:"margin_summary,omitempty"`
@@ -311,7 +311,7 @@ type AmountDue struct {
 	Amount string `json:"amount,omitempty"`
 
 	// The date this settlement is due, expressed in UTC
-	DueDate string `json:"due_date,omitempty"`
+	DueDate Timestamp `json:"due_date,omitzero"`
 }
 
 type PostTradeCreditInfo struct {
@@ -391,13 +391,13 @@ type Locate struct {
 	ApprovedAmount string `json:"approved_amount,omitempty"`
 
 	// Deprecated: Use locate_date instead
-	ConversionDate string `json:"conversion_date,omitempty"`
+	ConversionDate Timestamp `json:"conversion_date,omitzero"`
 
 	// The date when the locate was submitted in RFC3339 format
-	CreatedAt string `json:"created_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitzero"`
 
 	// The locate date from the CreateNewLocatesRequest in RFC3339 format
-	LocateDate string `json:"locate_date,omitempty"`
+	LocateDate Timestamp `json:"locate_date,omitzero"`
 }
 
 type Benchmark string
@@ -425,7 +425,7 @@ type Accrual struct {
 	AccrualId string `json:"accrual_id,omitempty"`
 
 	// The date of accrual in UTC
-	Date string `json:"date,omitempty"`
+	Date Timestamp `json:"date,omitzero"`
 
 	// The unique ID of the portfolio
 	PortfolioId string `json:"portfolio_id,omitempty"`
@@ -504,7 +504,7 @@ type Conversion struct {
 	ShortCollateral *ShortCollateral `json:"short_collateral,omitempty"`
 
 	// The UTC date time used for conversion
-	ConversionDatetime string `json:"conversion_datetime,omitempty"`
+	ConversionDatetime Timestamp `json:"conversion_datetime,omitzero"`
 
 	// Portfolio ID
 	PortfolioId string `json:"portfolio_id,omitempty"`
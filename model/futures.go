@@ -53,8 +53,8 @@ type FcmMarginCall struct {
 	State           FcmMarginCallState `json:"state"`
 	InitialAmount   string             `json:"initial_amount"`
 	RemainingAmount string             `json:"remaining_amount"`
-	BusinessDate    string             `json:"business_date"`
-	CureDeadline    string             `json:"cure_deadline"`
+	BusinessDate    Timestamp          `json:"business_date"`
+	CureDeadline    Timestamp          `json:"cure_deadline"`
 }
 
 // FcmRiskLimits represents FCM risk limits for an entity
@@ -87,14 +87,14 @@ type FcmBalance struct {
 
 // FcmPosition represents a futures position
 type FcmPosition struct {
-	ProductId         string `json:"product_id"`
-	Side              string `json:"side"`
-	NumberOfContracts string `json:"number_of_contracts"`
-	DailyRealizedPnl  string `json:"daily_realized_pnl"`
-	UnrealizedPnl     string `json:"unrealized_pnl"`
-	CurrentPrice      string `json:"current_price"`
-	AvgEntryPrice     string `json:"avg_entry_price"`
-	ExpirationTime    string `json:"expiration_time"`
+	ProductId         string    `json:"product_id"`
+	Side              string    `json:"side"`
+	NumberOfContracts string    `json:"number_of_contracts"`
+	DailyRealizedPnl  string    `json:"daily_realized_pnl"`
+	UnrealizedPnl     string    `json:"unrealized_pnl"`
+	CurrentPrice      string    `json:"current_price"`
+	AvgEntryPrice     string    `json:"avg_entry_price"`
+	ExpirationTime    Timestamp `json:"expiration_time"`
 }
 
 // FcmSweep represents a futures sweep
@@ -103,7 +103,7 @@ type FcmSweep struct {
 	RequestedAmount *RequestedAmount `json:"requested_amount"`
 	ShouldSweepAll  bool             `json:"should_sweep_all"`
 	Status          string           `json:"status"`
-	ScheduledTime   string           `json:"scheduled_time"`
+	ScheduledTime   Timestamp        `json:"scheduled_time"`
 }
 
 // RequestedAmount represents a requested amount with currency
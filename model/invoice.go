@@ -43,7 +43,7 @@ type Invoice struct {
 	Id            string         `json:"id"`
 	BillingYear   int32          `json:"billing_year"`
 	BillingMonth  int32          `json:"billing_month"`
-	DueDate       string         `json:"due_date"`
+	DueDate       Timestamp      `json:"due_date"`
 	InvoiceNumber string         `json:"invoice_number"`
 	State         InvoiceState   `json:"state"`
 	UsdAmountPaid float64        `json:"usd_amount_paid"`
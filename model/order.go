@@ -16,23 +16,80 @@
 
 package model
 
-import "time"
+// OrderType is the execution strategy of an order
+type OrderType string
 
-// Order type constants
 const (
-	OrderTypeMarket = "MARKET"
-	OrderTypeLimit  = "LIMIT"
-	OrderTypeTwap   = "TWAP"
-	OrderTypeBlock  = "BLOCK"
+	OrderTypeMarket    OrderType = "MARKET"
+	OrderTypeLimit     OrderType = "LIMIT"
+	OrderTypeTwap      OrderType = "TWAP"
+	OrderTypeBlock     OrderType = "BLOCK"
+	OrderTypeVwap      OrderType = "VWAP"
+	OrderTypeStopLimit OrderType = "STOP_LIMIT"
+	OrderTypeRfq       OrderType = "RFQ"
+	OrderTypePeg       OrderType = "PEG"
 )
 
-// Time in force constants
+// IsValid returns true if t is an order type listed in the API spec
+func (t OrderType) IsValid() bool {
+	switch t {
+	case OrderTypeMarket, OrderTypeLimit, OrderTypeTwap, OrderTypeBlock,
+		OrderTypeVwap, OrderTypeStopLimit, OrderTypeRfq, OrderTypePeg:
+		return true
+	}
+	return false
+}
+
+// TimeInForce is how long an order remains valid
+type TimeInForce string
+
 const (
-	TimeInForceGoodUntilTime      = "GOOD_UNTIL_DATE_TIME"
-	TimeInForceGoodUntilCancelled = "GOOD_UNTIL_CANCELLED"
-	TimeInForceImmediateOrCancel  = "IMMEDIATE_OR_CANCEL"
+	TimeInForceGoodUntilTime      TimeInForce = "GOOD_UNTIL_DATE_TIME"
+	TimeInForceGoodUntilCancelled TimeInForce = "GOOD_UNTIL_CANCELLED"
+	TimeInForceImmediateOrCancel  TimeInForce = "IMMEDIATE_OR_CANCEL"
+	TimeInForceFillOrKill         TimeInForce = "FILL_OR_KILL"
 )
 
+// IsValid returns true if t is a time in force listed in the API spec
+func (t TimeInForce) IsValid() bool {
+	switch t {
+	case TimeInForceGoodUntilTime, TimeInForceGoodUntilCancelled, TimeInForceImmediateOrCancel, TimeInForceFillOrKill:
+		return true
+	}
+	return false
+}
+
+// OrderStatus is the state of an order
+type OrderStatus string
+
+const (
+	OrderStatusOpen      OrderStatus = "OPEN"
+	OrderStatusFilled    OrderStatus = "FILLED"
+	OrderStatusCancelled OrderStatus = "CANCELLED"
+	OrderStatusExpired   OrderStatus = "EXPIRED"
+	OrderStatusFailed    OrderStatus = "FAILED"
+	OrderStatusPending   OrderStatus = "PENDING"
+)
+
+// IsValid returns true if s is an order status listed in the API spec
+func (s OrderStatus) IsValid() bool {
+	switch s {
+	case OrderStatusOpen, OrderStatusFilled, OrderStatusCancelled, OrderStatusExpired, OrderStatusFailed, OrderStatusPending:
+		return true
+	}
+	return false
+}
+
+// IsTerminal returns true for statuses an order never leaves: FILLED, CANCELLED, EXPIRED
+// and FAILED (rejected orders are reported as FAILED).
+func (s OrderStatus) IsTerminal() bool {
+	switch s {
+	case OrderStatusFilled, OrderStatusCancelled, OrderStatusExpired, OrderStatusFailed:
+		return true
+	}
+	return false
+}
+
 // OrderSide represents the side of an order (buy or sell)
 type OrderSide string
 
@@ -49,9 +106,9 @@ type Order struct {
 
 	// A client-generated order ID used for reference purposes (note: order will be rejected if this ID
 	// is not unique among all currently active orders)
-	ClientOrderId string `json:"client_order_id"`
-	ProductId     string `json:"product_id"`
-	Type          string `json:"type"`
+	ClientOrderId string    `json:"client_order_id"`
+	ProductId     string    `json:"product_id"`
+	Type          OrderType `json:"type"`
 
 	// Order size in base asset units (either `base_quantity` or `quote_value` is required)
 	BaseQuantity string `json:"base_quantity"`
@@ -64,11 +121,11 @@ type Order struct {
 	LimitPrice string `json:"limit_price,omitempty"`
 
 	// The start time of the order in UTC (TWAP only)
-	StartTime string `json:"start_time,omitempty"`
+	StartTime Timestamp `json:"start_time,omitzero"`
 
 	// The expiry time of the order in UTC (TWAP and limit GTD only)
-	ExpiryTime  string `json:"expiry_time,omitempty"`
-	TimeInForce string `json:"time_in_force,omitempty"`
+	ExpiryTime  Timestamp   `json:"expiry_time,omitzero"`
+	TimeInForce TimeInForce `json:"time_in_force,omitempty"`
 
 	// An optional self trade prevention id (in the form of a UUID). The value is only honored for certain
 	// clients who are permitted to specify a custom self trade prevention id
@@ -85,29 +142,29 @@ type Order struct {
 	IsRaiseExact bool `json:"is_raise_exact,omitempty"`
 
 	// Used for describe order, create order preview, and list portfolio orders
-	Id                    string `json:"id,omitempty"`
-	UserId                string `json:"user_id,omitempty"`
-	Created               string `json:"created_at,omitempty"`
-	FilledQuantity        string `json:"filled_quantity,omitempty"`
-	FilledValue           string `json:"filled_value,omitempty"`
-	AverageFilledPrice    string `json:"average_filled_price,omitempty"`
-	Commission            string `json:"commission,omitempty"`
-	ExchangeFee           string `json:"exchange_fee,omitempty"`
-	Total                 string `json:"order_total,omitempty"`
-	BestBid               string `json:"best_bid,omitempty"`
-	BestAsk               string `json:"best_ask,omitempty"`
-	Slippage              string `json:"slippage,omitempty"`
-	Status                string `json:"status,omitempty"`
-	HistoricalPov         string `json:"historical_pov,omitempty"`
-	StopPrice             string `json:"stop_price,omitempty"`
-	NetAverageFilledPrice string `json:"net_average_filled_price,omitempty"`
-	UserContext           string `json:"user_context,omitempty"`
-	ClientProductId       string `json:"client_product_id,omitempty"`
-	PostOnly              bool   `json:"post_only,omitempty"`
+	Id                    string      `json:"id,omitempty"`
+	UserId                string      `json:"user_id,omitempty"`
+	Created               Timestamp   `json:"created_at,omitzero"`
+	FilledQuantity        string      `json:"filled_quantity,omitempty"`
+	FilledValue           string      `json:"filled_value,omitempty"`
+	AverageFilledPrice    string      `json:"average_filled_price,omitempty"`
+	Commission            string      `json:"commission,omitempty"`
+	ExchangeFee           string      `json:"exchange_fee,omitempty"`
+	Total                 string      `json:"order_total,omitempty"`
+	BestBid               string      `json:"best_bid,omitempty"`
+	BestAsk               string      `json:"best_ask,omitempty"`
+	Slippage              string      `json:"slippage,omitempty"`
+	Status                OrderStatus `json:"status,omitempty"`
+	HistoricalPov         string      `json:"historical_pov,omitempty"`
+	StopPrice             string      `json:"stop_price,omitempty"`
+	NetAverageFilledPrice string      `json:"net_average_filled_price,omitempty"`
+	UserContext           string      `json:"user_context,omitempty"`
+	ClientProductId       string      `json:"client_product_id,omitempty"`
+	PostOnly              bool        `json:"post_only,omitempty"`
 	// Deprecated: Use EditHistory instead
-	OrderEditHistory []*OrderEditHistory `json:"order_edit_history,omitempty"`
-	DisplaySize      string              `json:"display_size,omitempty"`
-	EditHistory      []*EditHistory      `json:"edit_history,omitempty"`
+	OrderEditHistory      []*OrderEditHistory    `json:"order_edit_history,omitempty"`
+	DisplaySize           string                 `json:"display_size,omitempty"`
+	EditHistory           []*EditHistory         `json:"edit_history,omitempty"`
 	PegOffsetType         string                 `json:"peg_offset_type,omitempty"`
 	Offset                string                 `json:"offset,omitempty"`
 	WigLevel              string                 `json:"wig_level,omitempty"`
@@ -136,7 +193,7 @@ type OrderFill struct {
 	FilledQuantity        string                 `json:"filled_quantity"`
 	FilledValue           string                 `json:"filled_value"`
 	Price                 string                 `json:"price"`
-	Time                  time.Time              `json:"time"`
+	Time                  Timestamp              `json:"time"`
 	Commission            string                 `json:"commission"`
 	Venue                 string                 `json:"venue"`
 	VenueFees             string                 `json:"venue_fees"`
@@ -147,26 +204,26 @@ type OrderFill struct {
 
 // EditHistory represents an order edit entry (new format)
 type EditHistory struct {
-	Price            string `json:"price"`
-	BaseQuantity     string `json:"base_quantity"`
-	QuoteValue       string `json:"quote_value"`
-	DisplayBaseSize  string `json:"display_base_size"`
-	DisplayQuoteSize string `json:"display_quote_size"`
-	StopPrice        string `json:"stop_price"`
-	ExpiryTime       string `json:"expiry_time"`
-	AcceptTime       string `json:"accept_time"`
-	ClientOrderId    string `json:"client_order_id"`
+	Price            string    `json:"price"`
+	BaseQuantity     string    `json:"base_quantity"`
+	QuoteValue       string    `json:"quote_value"`
+	DisplayBaseSize  string    `json:"display_base_size"`
+	DisplayQuoteSize string    `json:"display_quote_size"`
+	StopPrice        string    `json:"stop_price"`
+	ExpiryTime       Timestamp `json:"expiry_time"`
+	AcceptTime       Timestamp `json:"accept_time"`
+	ClientOrderId    string    `json:"client_order_id"`
 }
 
 // OrderEditHistory represents an order edit entry (deprecated format)
 // Deprecated: Use EditHistory instead
 type OrderEditHistory struct {
-	Price          string `json:"price"`
-	Size           string `json:"size"`
-	DisplaySize    string `json:"display_size"`
-	StopPrice      string `json:"stop_price"`
-	StopLimitPrice string `json:"stop_limit_price"`
-	EndTime        string `json:"end_time"`
-	AcceptTime     string `json:"accept_time"`
-	ClientOrderId  string `json:"client_order_id"`
+	Price          string    `json:"price"`
+	Size           string    `json:"size"`
+	DisplaySize    string    `json:"display_size"`
+	StopPrice      string    `json:"stop_price"`
+	StopLimitPrice string    `json:"stop_limit_price"`
+	EndTime        Timestamp `json:"end_time"`
+	AcceptTime     Timestamp `json:"accept_time"`
+	ClientOrderId  string    `json:"client_order_id"`
 }
@@ -0,0 +1,259 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// BackfillConfig controls how Backfill shards a time range
+type BackfillConfig struct {
+	// Window is the initial size of each time window (default 24h)
+	Window time.Duration
+	// Workers is the number of windows paged concurrently (default 4)
+	Workers int
+	// MaxPagesPerWindow splits a window in half once it needs more pages than this (0 = never split)
+	MaxPagesPerWindow int
+	// MinWindow is the smallest window produced by splitting (default 1m)
+	MinWindow time.Duration
+}
+
+// DefaultBackfillConfig returns a config with day-sized windows and four workers
+func DefaultBackfillConfig() *BackfillConfig {
+	return &BackfillConfig{
+		Window:            24 * time.Hour,
+		Workers:           4,
+		MaxPagesPerWindow: 0,
+		MinWindow:         time.Minute,
+	}
+}
+
+// BackfillSource describes how to query and read a date-ranged list endpoint
+type BackfillSource[R PaginatedResponse[R], I any] struct {
+	// Fetch returns the first page of results between start and end
+	Fetch func(ctx context.Context, start, end time.Time) (R, error)
+	// Items extracts the items from a page
+	Items ItemExtractor[R, I]
+	// Id returns the unique id used to dedupe items seen in more than one window
+	Id func(I) string
+	// Time returns the timestamp used to merge results in time order
+	Time func(I) time.Time
+}
+
+// ListBackfill adapts a date-ranged list request to Backfill
+type ListBackfill[Q any, R PaginatedResponse[R], I any] struct {
+	// Start and End are the requested range. Start is required and a zero End means now.
+	Start time.Time
+	End   time.Time
+	// Window returns a copy of the request restricted to [start, end), positioned at the first page
+	Window func(start, end time.Time) *Q
+	// List issues a request, typically the service method of the endpoint
+	List  func(context.Context, *Q) (R, error)
+	Items ItemExtractor[R, I]
+	Id    func(I) string
+	Time  func(I) time.Time
+}
+
+// BackfillList runs Backfill over a list endpoint, issuing a windowed copy of the request per window
+func BackfillList[Q any, R PaginatedResponse[R], I any](
+	ctx context.Context,
+	list ListBackfill[Q, R, I],
+	config *BackfillConfig,
+) ([]I, error) {
+	end := list.End
+	if end.IsZero() {
+		end = time.Now().UTC()
+	}
+
+	return Backfill(ctx, list.Start, end, BackfillSource[R, I]{
+		Fetch: func(ctx context.Context, start, end time.Time) (R, error) {
+			return list.List(ctx, list.Window(start, end))
+		},
+		Items: list.Items,
+		Id:    list.Id,
+		Time:  list.Time,
+	}, config)
+}
+
+type backfillWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// Backfill splits [start, end) into windows and pages each window concurrently on Workers goroutines.
+// Results are deduped by id and returned in ascending time order. Windows that need more than
+// MaxPagesPerWindow pages are discarded and re-queued as two halves. The first error cancels
+// all outstanding work and is returned. A zero start is rejected rather than backfilling from year 1.
+func Backfill[R PaginatedResponse[R], I any](
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+	source BackfillSource[R, I],
+	config *BackfillConfig,
+) ([]I, error) {
+	if start.IsZero() {
+		return nil, errors.New("backfill start is required")
+	}
+	if !end.After(start) {
+		return nil, errors.New("backfill end must be after start")
+	}
+
+	cfg := *DefaultBackfillConfig()
+	if config != nil {
+		if config.Window > 0 {
+			cfg.Window = config.Window
+		}
+		if config.Workers > 0 {
+			cfg.Workers = config.Workers
+		}
+		if config.MinWindow > 0 {
+			cfg.MinWindow = config.MinWindow
+		}
+		cfg.MaxPagesPerWindow = config.MaxPagesPerWindow
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// queue holds windows waiting for a worker and active counts windows being paged; the
+	// backfill is done when both are empty
+	var (
+		mu       sync.Mutex
+		ready    = sync.NewCond(&mu)
+		queue    []backfillWindow
+		active   int
+		seen     = make(map[string]struct{})
+		results  []I
+		firstErr error
+		workers  sync.WaitGroup
+	)
+
+	// Wake idle workers when the backfill is cancelled
+	stop := context.AfterFunc(ctx, func() {
+		mu.Lock()
+		ready.Broadcast()
+		mu.Unlock()
+	})
+	defer stop()
+
+	for ws := start; ws.Before(end); ws = ws.Add(cfg.Window) {
+		we := ws.Add(cfg.Window)
+		if we.After(end) {
+			we = end
+		}
+		queue = append(queue, backfillWindow{start: ws, end: we})
+	}
+
+	next := func() (backfillWindow, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		for len(queue) == 0 && active > 0 && ctx.Err() == nil {
+			ready.Wait()
+		}
+		if len(queue) == 0 || ctx.Err() != nil {
+			return backfillWindow{}, false
+		}
+		w := queue[0]
+		queue = queue[1:]
+		active++
+		return w, true
+	}
+
+	// finish records the outcome of a window, queueing its halves when it was split
+	finish := func(items []I, split []backfillWindow, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		active--
+		if err != nil && firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		queue = append(queue, split...)
+		for _, item := range items {
+			id := source.Id(item)
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			results = append(results, item)
+		}
+		ready.Broadcast()
+	}
+
+	process := func(w backfillWindow) ([]I, []backfillWindow, error) {
+		resp, err := source.Fetch(ctx, w.start, w.end)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var items []I
+		for pages := 1; ; pages++ {
+			items = append(items, source.Items(resp)...)
+			if !resp.HasNext() {
+				return items, nil, nil
+			}
+
+			if cfg.MaxPagesPerWindow > 0 && pages >= cfg.MaxPagesPerWindow {
+				half := w.end.Sub(w.start) / 2
+				if half >= cfg.MinWindow {
+					mid := w.start.Add(half)
+					return nil, []backfillWindow{{start: w.start, end: mid}, {start: mid, end: w.end}}, nil
+				}
+			}
+
+			if resp, err = resp.Next(ctx); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	for i := 0; i < cfg.Workers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for {
+				w, ok := next()
+				if !ok {
+					return
+				}
+				finish(process(w))
+			}
+		}()
+	}
+	workers.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		ti, tj := source.Time(results[i]), source.Time(results[j])
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return source.Id(results[i]) < source.Id(results[j])
+	})
+
+	return results, nil
+}
@@ -0,0 +1,229 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type timedItem struct {
+	Id string
+	At time.Time
+}
+
+type timedPage struct {
+	PaginationMixin
+	Items  []timedItem
+	source *timedSource
+	start  time.Time
+	end    time.Time
+	offset int
+}
+
+func (p *timedPage) Next(ctx context.Context) (*timedPage, error) {
+	offset, _ := strconv.Atoi(p.GetNextCursor())
+	return p.source.page(p.start, p.end, offset)
+}
+
+// timedSource serves one item per hour, inclusive of both window bounds to exercise dedupe
+type timedSource struct {
+	mu       sync.Mutex
+	items    []timedItem
+	pageSize int
+	fetches  int
+	failOn   time.Time
+}
+
+func (s *timedSource) page(start, end time.Time, offset int) (*timedPage, error) {
+	s.mu.Lock()
+	s.fetches++
+	s.mu.Unlock()
+
+	if !s.failOn.IsZero() && !s.failOn.Before(start) && s.failOn.Before(end) {
+		return nil, errors.New("boom")
+	}
+
+	var matched []timedItem
+	for _, it := range s.items {
+		if !it.At.Before(start) && !it.At.After(end) {
+			matched = append(matched, it)
+		}
+	}
+
+	p := &timedPage{source: s, start: start, end: end, offset: offset}
+	stop := offset + s.pageSize
+	if stop > len(matched) {
+		stop = len(matched)
+	}
+	p.Items = matched[offset:stop]
+	if stop < len(matched) {
+		p.Pagination = &Pagination{HasNext: true, NextCursor: fmt.Sprint(stop)}
+	}
+	return p, nil
+}
+
+func (s *timedSource) backfillSource() BackfillSource[*timedPage, timedItem] {
+	return BackfillSource[*timedPage, timedItem]{
+		Fetch: func(ctx context.Context, start, end time.Time) (*timedPage, error) {
+			return s.page(start, end, 0)
+		},
+		Items: func(p *timedPage) []timedItem { return p.Items },
+		Id:    func(i timedItem) string { return i.Id },
+		Time:  func(i timedItem) time.Time { return i.At },
+	}
+}
+
+func newTimedSource(start time.Time, hours, pageSize int) *timedSource {
+	s := &timedSource{pageSize: pageSize}
+	// Insert in reverse so ordering comes from Backfill, not the source
+	for i := hours - 1; i >= 0; i-- {
+		s.items = append(s.items, timedItem{Id: fmt.Sprintf("id-%03d", i), At: start.Add(time.Duration(i) * time.Hour)})
+	}
+	return s
+}
+
+func TestBackfillMergesAndDedupes(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := newTimedSource(start, 72, 5)
+
+	items, err := Backfill(context.Background(), start, start.Add(72*time.Hour), source.backfillSource(), &BackfillConfig{
+		Window:  6 * time.Hour,
+		Workers: 3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(items) != 72 {
+		t.Fatalf("expected 72 unique items, got %d", len(items))
+	}
+	for i, it := range items {
+		if it.Id != fmt.Sprintf("id-%03d", i) {
+			t.Fatalf("expected time ordered results, item %d is %s", i, it.Id)
+		}
+	}
+}
+
+func TestBackfillSplitsLargeWindows(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := newTimedSource(start, 48, 2)
+
+	items, err := Backfill(context.Background(), start, start.Add(48*time.Hour), source.backfillSource(), &BackfillConfig{
+		Window:            48 * time.Hour,
+		Workers:           2,
+		MaxPagesPerWindow: 3,
+		MinWindow:         time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 48 {
+		t.Fatalf("expected 48 unique items, got %d", len(items))
+	}
+	// A single 48h window would need 24 pages; splitting must have produced more, smaller windows
+	if source.fetches <= 3 {
+		t.Errorf("expected window to be split, got %d fetches", source.fetches)
+	}
+}
+
+func TestBackfillReturnsFirstError(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := newTimedSource(start, 24, 5)
+	source.failOn = start.Add(13 * time.Hour)
+
+	_, err := Backfill(context.Background(), start, start.Add(24*time.Hour), source.backfillSource(), &BackfillConfig{
+		Window:  time.Hour,
+		Workers: 4,
+	})
+	if err == nil || err.Error() != "boom" {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
+
+func TestBackfillRejectsEmptyRange(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := newTimedSource(start, 1, 1)
+
+	if _, err := Backfill(context.Background(), start, start, source.backfillSource(), nil); err == nil {
+		t.Fatal("expected error for empty range")
+	}
+}
+
+func TestBackfillRejectsZeroStart(t *testing.T) {
+	source := newTimedSource(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 1, 1)
+
+	if _, err := Backfill(context.Background(), time.Time{}, time.Now(), source.backfillSource(), nil); err == nil {
+		t.Fatal("expected error for zero start")
+	}
+}
+
+type windowedRequest struct {
+	Start      time.Time
+	End        time.Time
+	Pagination *PaginationParams
+}
+
+func TestBackfillListWindowsRequest(t *testing.T) {
+	start := time.Now().UTC().Truncate(time.Hour).Add(-5 * time.Hour)
+	source := newTimedSource(start, 5, 2)
+	request := &windowedRequest{Start: start, Pagination: &PaginationParams{Cursor: "stale", Limit: 2}}
+
+	var mu sync.Mutex
+	var windows []*windowedRequest
+	items, err := BackfillList(context.Background(), ListBackfill[windowedRequest, *timedPage, timedItem]{
+		Start: request.Start,
+		End:   request.End,
+		Window: func(start, end time.Time) *windowedRequest {
+			window := *request
+			window.Start, window.End, window.Pagination = start, end, FirstPage(request.Pagination)
+			return &window
+		},
+		List: func(ctx context.Context, r *windowedRequest) (*timedPage, error) {
+			mu.Lock()
+			windows = append(windows, r)
+			mu.Unlock()
+			return source.page(r.Start, r.End, 0)
+		},
+		Items: func(p *timedPage) []timedItem { return p.Items },
+		Id:    func(i timedItem) string { return i.Id },
+		Time:  func(i timedItem) time.Time { return i.At },
+	}, &BackfillConfig{Window: 2 * time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(items) != 5 {
+		t.Errorf("expected a zero end to backfill up to now, got %d items", len(items))
+	}
+	if len(windows) != 3 {
+		t.Fatalf("expected 3 windows, got %d", len(windows))
+	}
+	for _, w := range windows {
+		if w.End.IsZero() || w.Pagination.Cursor != "" || w.Pagination.Limit != 2 {
+			t.Errorf("expected a bounded first-page request, got %+v %+v", w, w.Pagination)
+		}
+	}
+	if request.Pagination.Cursor != "stale" {
+		t.Errorf("expected the caller's request to be left untouched")
+	}
+}
@@ -0,0 +1,289 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/internal/jsonfile"
+)
+
+// ErrCheckpointMismatch is returned when resuming from a checkpoint saved for a different request
+var ErrCheckpointMismatch = errors.New("checkpoint belongs to a different request")
+
+// Checkpoint records how far a paginated listing has been consumed so it can be resumed
+type Checkpoint struct {
+	// Key identifies the listing, e.g. "nightly-transactions-2026-10-18"
+	Key string `json:"key"`
+	// Fingerprint hashes the request type and the initial request without its cursor, so a key
+	// reused for another endpoint, portfolio or filter set is not resumed
+	Fingerprint string `json:"fingerprint"`
+	// Request is the JSON encoded request that fetches the next unconsumed page
+	Request json.RawMessage `json:"request"`
+	// NextCursor is the cursor of the next unconsumed page (empty before the first page)
+	NextCursor string `json:"next_cursor"`
+	// Items is the number of items committed so far
+	Items int `json:"items"`
+	// Pages is the number of pages committed so far
+	Pages int `json:"pages"`
+	// Done is true once the last page has been committed
+	Done      bool      `json:"done"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CheckpointStore persists checkpoints. Load returns nil, nil when no checkpoint exists for key.
+type CheckpointStore interface {
+	Load(ctx context.Context, key string) (*Checkpoint, error)
+	Save(ctx context.Context, checkpoint *Checkpoint) error
+	Delete(ctx context.Context, key string) error
+}
+
+// PageCommitter consumes a page of items. The checkpoint passed in marks the page as consumed and is
+// saved to the store only after the committer returns nil, so a failed page is redelivered on resume.
+// Writing the items and the checkpoint in one transaction of the same datastore gives exactly-once
+// processing.
+type PageCommitter[I any] func(ctx context.Context, items []I, checkpoint *Checkpoint) error
+
+// ResumableIterator pages through a list endpoint, saving a checkpoint after every committed page
+type ResumableIterator[Q any, R PaginatedResponse[R], I any] struct {
+	key       string
+	store     CheckpointStore
+	request   *Q
+	fetch     func(context.Context, *Q) (R, error)
+	extractor ItemExtractor[R, I]
+	cursor    func(*Q, string) *Q
+	config    *ServiceConfig
+}
+
+// NewResumableIterator creates a resumable iterator. fetch issues a list request, and cursor returns a copy
+// of a request positioned at the given cursor. Service packages wrap this for their list endpoints.
+func NewResumableIterator[Q any, R PaginatedResponse[R], I any](
+	key string,
+	store CheckpointStore,
+	request *Q,
+	fetch func(context.Context, *Q) (R, error),
+	extractor ItemExtractor[R, I],
+	cursor func(*Q, string) *Q,
+) *ResumableIterator[Q, R, I] {
+	return &ResumableIterator[Q, R, I]{
+		key:       key,
+		store:     store,
+		request:   request,
+		fetch:     fetch,
+		extractor: extractor,
+		cursor:    cursor,
+	}
+}
+
+// WithConfig sets the pagination config and returns the iterator for chaining.
+// MaxPages and MaxItems apply to a single run, not to the total across resumes.
+func (it *ResumableIterator[Q, R, I]) WithConfig(config *ServiceConfig) *ResumableIterator[Q, R, I] {
+	it.config = config
+	return it
+}
+
+// Checkpoint returns the stored checkpoint for this iterator, or nil if none has been saved
+func (it *ResumableIterator[Q, R, I]) Checkpoint(ctx context.Context) (*Checkpoint, error) {
+	return it.store.Load(ctx, it.key)
+}
+
+// Reset deletes the stored checkpoint so the next ForEach starts from the first page
+func (it *ResumableIterator[Q, R, I]) Reset(ctx context.Context) error {
+	return it.store.Delete(ctx, it.key)
+}
+
+// ForEach fetches pages starting from the stored checkpoint (or from the initial request when there
+// is none) and hands each page to commit. It returns nil immediately if the checkpoint is already done,
+// and ErrCheckpointMismatch if the checkpoint was saved for a different request; Reset discards it.
+func (it *ResumableIterator[Q, R, I]) ForEach(ctx context.Context, commit PageCommitter[I]) error {
+	cp, err := it.store.Load(ctx, it.key)
+	if err != nil {
+		return fmt.Errorf("unable to load checkpoint %s: %w", it.key, err)
+	}
+
+	fingerprint, err := it.fingerprint()
+	if err != nil {
+		return err
+	}
+
+	request := it.request
+	if cp != nil {
+		if cp.Fingerprint != fingerprint {
+			return fmt.Errorf("unable to resume checkpoint %s: %w", it.key, ErrCheckpointMismatch)
+		}
+		if cp.Done {
+			return nil
+		}
+		request = new(Q)
+		if err := json.Unmarshal(cp.Request, request); err != nil {
+			return fmt.Errorf("unable to decode checkpoint %s request: %w", it.key, err)
+		}
+	} else {
+		cp = &Checkpoint{Key: it.key, Fingerprint: fingerprint}
+	}
+
+	items := 0
+	for pages := 0; ; pages++ {
+		if it.config != nil && it.config.MaxPages > 0 && pages >= it.config.MaxPages {
+			return nil
+		}
+		if it.config != nil && it.config.MaxItems > 0 && items >= it.config.MaxItems {
+			return nil
+		}
+
+		resp, err := it.fetch(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		pageItems := it.extractor(resp)
+		items += len(pageItems)
+
+		next := *cp
+		next.Items += len(pageItems)
+		next.Pages++
+		next.NextCursor = resp.GetNextCursor()
+		next.Done = !resp.HasNext()
+		next.UpdatedAt = time.Now().UTC()
+
+		nextRequest := request
+		if !next.Done {
+			nextRequest = it.cursor(request, next.NextCursor)
+		}
+		if next.Request, err = json.Marshal(nextRequest); err != nil {
+			return fmt.Errorf("unable to encode checkpoint %s request: %w", it.key, err)
+		}
+
+		if err := commit(ctx, pageItems, &next); err != nil {
+			return err
+		}
+
+		if err := it.store.Save(ctx, &next); err != nil {
+			return fmt.Errorf("unable to save checkpoint %s: %w", it.key, err)
+		}
+
+		if next.Done {
+			return nil
+		}
+
+		cp = &next
+		request = nextRequest
+	}
+}
+
+// fingerprint identifies the listing by the request type, which determines the endpoint, and the
+// initial request positioned at the first page, which holds the path parameters and filters
+func (it *ResumableIterator[Q, R, I]) fingerprint() (string, error) {
+	b, err := json.Marshal(it.cursor(it.request, ""))
+	if err != nil {
+		return "", fmt.Errorf("unable to encode checkpoint %s request: %w", it.key, err)
+	}
+	sum := sha256.Sum256(append([]byte(fmt.Sprintf("%T:", it.request)), b...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// MemoryCheckpointStore is an in-process CheckpointStore, mostly useful for tests
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+// NewMemoryCheckpointStore creates an empty in-memory checkpoint store
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: make(map[string]Checkpoint)}
+}
+
+func (s *MemoryCheckpointStore) Load(ctx context.Context, key string) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cp, ok := s.checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+	return &cp, nil
+}
+
+func (s *MemoryCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[checkpoint.Key] = *checkpoint
+	return nil
+}
+
+func (s *MemoryCheckpointStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.checkpoints, key)
+	return nil
+}
+
+// FileCheckpointStore keeps one JSON file per checkpoint key in a directory, replaced atomically on save
+type FileCheckpointStore struct {
+	dir string
+}
+
+// NewFileCheckpointStore creates a checkpoint store rooted at dir, creating the directory if needed
+func NewFileCheckpointStore(dir string) (*FileCheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create checkpoint dir %s: %w", dir, err)
+	}
+	return &FileCheckpointStore{dir: dir}, nil
+}
+
+func (s *FileCheckpointStore) path(key string) string {
+	return filepath.Join(s.dir, url.PathEscape(key)+".json")
+}
+
+func (s *FileCheckpointStore) Load(ctx context.Context, key string) (*Checkpoint, error) {
+	b, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cp := &Checkpoint{}
+	if err := json.Unmarshal(b, cp); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint file %s: %w", s.path(key), err)
+	}
+	return cp, nil
+}
+
+func (s *FileCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) error {
+	return jsonfile.Write(s.path(checkpoint.Key), checkpoint)
+}
+
+func (s *FileCheckpointStore) Delete(ctx context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
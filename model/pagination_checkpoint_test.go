@@ -0,0 +1,227 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+type fakeRequest struct {
+	Filter     string            `json:"filter"`
+	Pagination *PaginationParams `json:"pagination_params"`
+}
+
+type fakeResponse struct {
+	PaginationMixin
+	Items   []int
+	request *fakeRequest
+	pager   *fakePager
+}
+
+func (r *fakeResponse) Next(ctx context.Context) (*fakeResponse, error) {
+	if !r.HasNext() {
+		return nil, nil
+	}
+	next := *r.request
+	next.Pagination = PrepareNextPagination(r.request.Pagination, r.GetNextCursor())
+	return r.pager.list(ctx, &next)
+}
+
+// fakePager serves pages of pageSize consecutive ints, using the page index as cursor
+type fakePager struct {
+	total    int
+	pageSize int
+	fetches  int
+	failAt   int
+}
+
+func (p *fakePager) list(ctx context.Context, request *fakeRequest) (*fakeResponse, error) {
+	p.fetches++
+	page := 0
+	if request.Pagination != nil && request.Pagination.Cursor != "" {
+		page, _ = strconv.Atoi(request.Pagination.Cursor)
+	}
+	if p.failAt > 0 && page == p.failAt {
+		return nil, errors.New("transient failure")
+	}
+
+	resp := &fakeResponse{request: request, pager: p}
+	for i := page * p.pageSize; i < (page+1)*p.pageSize && i < p.total; i++ {
+		resp.Items = append(resp.Items, i)
+	}
+	if (page+1)*p.pageSize < p.total {
+		resp.Pagination = &Pagination{HasNext: true, NextCursor: fmt.Sprint(page + 1)}
+	}
+	return resp, nil
+}
+
+func newFakeResumable(p *fakePager, store CheckpointStore) *ResumableIterator[fakeRequest, *fakeResponse, int] {
+	return NewResumableIterator(
+		"fake",
+		store,
+		&fakeRequest{Filter: "x"},
+		p.list,
+		func(r *fakeResponse) []int { return r.Items },
+		func(q *fakeRequest, cursor string) *fakeRequest {
+			next := *q
+			next.Pagination = PrepareNextPagination(q.Pagination, cursor)
+			return &next
+		},
+	)
+}
+
+func TestResumableIteratorResumesAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryCheckpointStore()
+	pager := &fakePager{total: 10, pageSize: 3, failAt: 2}
+
+	var seen []int
+	collect := func(ctx context.Context, items []int, cp *Checkpoint) error {
+		seen = append(seen, items...)
+		return nil
+	}
+
+	if err := newFakeResumable(pager, store).ForEach(ctx, collect); err == nil {
+		t.Fatal("expected transient failure")
+	}
+
+	cp, _ := store.Load(ctx, "fake")
+	if cp == nil || cp.Pages != 2 || cp.Items != 6 || cp.NextCursor != "2" || cp.Done {
+		t.Fatalf("unexpected checkpoint after failure: %+v", cp)
+	}
+
+	pager.failAt = 0
+	pager.fetches = 0
+	if err := newFakeResumable(pager, store).ForEach(ctx, collect); err != nil {
+		t.Fatalf("unexpected error on resume: %v", err)
+	}
+
+	if pager.fetches != 2 {
+		t.Errorf("expected 2 fetches on resume, got %d", pager.fetches)
+	}
+	if len(seen) != 10 {
+		t.Fatalf("expected 10 items exactly once, got %v", seen)
+	}
+	for i, v := range seen {
+		if v != i {
+			t.Fatalf("expected items in order, got %v", seen)
+		}
+	}
+
+	cp, _ = store.Load(ctx, "fake")
+	if !cp.Done || cp.Items != 10 || cp.Pages != 4 {
+		t.Errorf("unexpected final checkpoint: %+v", cp)
+	}
+
+	pager.fetches = 0
+	if err := newFakeResumable(pager, store).ForEach(ctx, collect); err != nil || pager.fetches != 0 {
+		t.Errorf("expected completed checkpoint to skip fetching, got err %v and %d fetches", err, pager.fetches)
+	}
+}
+
+func TestResumableIteratorRedeliversUncommittedPage(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryCheckpointStore()
+	pager := &fakePager{total: 6, pageSize: 3}
+
+	commitErr := errors.New("db down")
+	err := newFakeResumable(pager, store).ForEach(ctx, func(ctx context.Context, items []int, cp *Checkpoint) error {
+		if items[0] == 3 {
+			return commitErr
+		}
+		return nil
+	})
+	if !errors.Is(err, commitErr) {
+		t.Fatalf("expected commit error, got %v", err)
+	}
+
+	var redelivered []int
+	if err := newFakeResumable(pager, store).ForEach(ctx, func(ctx context.Context, items []int, cp *Checkpoint) error {
+		redelivered = append(redelivered, items...)
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fmt.Sprint(redelivered) != "[3 4 5]" {
+		t.Errorf("expected second page to be redelivered, got %v", redelivered)
+	}
+}
+
+func TestResumableIteratorRejectsOtherRequest(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryCheckpointStore()
+	pager := &fakePager{total: 10, pageSize: 3, failAt: 2}
+	noop := func(ctx context.Context, items []int, cp *Checkpoint) error { return nil }
+
+	if err := newFakeResumable(pager, store).ForEach(ctx, noop); err == nil {
+		t.Fatal("expected transient failure")
+	}
+
+	pager.failAt = 0
+	pager.fetches = 0
+	other := newFakeResumable(pager, store)
+	other.request = &fakeRequest{Filter: "y"}
+	if err := other.ForEach(ctx, noop); !errors.Is(err, ErrCheckpointMismatch) {
+		t.Fatalf("expected checkpoint mismatch, got %v", err)
+	}
+	if pager.fetches != 0 {
+		t.Errorf("expected no fetches, got %d", pager.fetches)
+	}
+
+	if err := other.Reset(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := other.ForEach(ctx, noop); err != nil {
+		t.Fatalf("unexpected error after reset: %v", err)
+	}
+	if pager.fetches != 4 {
+		t.Errorf("expected a fresh run of 4 pages, got %d fetches", pager.fetches)
+	}
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileCheckpointStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cp, err := store.Load(ctx, "a/b"); cp != nil || err != nil {
+		t.Fatalf("expected missing checkpoint, got %v, %v", cp, err)
+	}
+
+	if err := store.Save(ctx, &Checkpoint{Key: "a/b", NextCursor: "c", Items: 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	cp, err := store.Load(ctx, "a/b")
+	if err != nil || cp.NextCursor != "c" || cp.Items != 5 {
+		t.Fatalf("unexpected checkpoint: %+v, %v", cp, err)
+	}
+
+	if err := store.Delete(ctx, "a/b"); err != nil {
+		t.Fatal(err)
+	}
+	if cp, _ := store.Load(ctx, "a/b"); cp != nil {
+		t.Errorf("expected checkpoint to be deleted, got %+v", cp)
+	}
+}
@@ -16,7 +16,10 @@
 
 package model
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ServiceConfig controls pagination behavior for services
 type ServiceConfig struct {
@@ -26,6 +29,20 @@ type ServiceConfig struct {
 	MaxItems int
 	// DefaultLimit is the default page size if not specified in the request
 	DefaultLimit int32
+	// OnPage is called after each page is processed by FetchAll, ForEach and Stream (nil = disabled)
+	OnPage func(PageProgress)
+}
+
+// PageProgress reports progress of a multi-page iteration
+type PageProgress struct {
+	// Page is the 1-based number of the page just processed
+	Page int
+	// Items is the number of items kept so far, after filtering
+	Items int
+	// Fetched is the number of items received so far, before filtering
+	Fetched int
+	// Elapsed is the time since the iteration started
+	Elapsed time.Duration
 }
 
 // DefaultServiceConfig returns a config with no limits
@@ -67,6 +84,14 @@ func PrepareNextPagination(current *PaginationParams, nextCursor string) *Pagina
 	return &cp
 }
 
+// FirstPage returns a copy of the pagination params without a cursor, or nil when current is nil
+func FirstPage(current *PaginationParams) *PaginationParams {
+	if current == nil {
+		return nil
+	}
+	return PrepareNextPagination(current, "")
+}
+
 // PaginatedResponse is implemented by any response that supports pagination
 type PaginatedResponse[T any] interface {
 	HasNext() bool
@@ -82,6 +107,9 @@ type PageIterator[R PaginatedResponse[R], I any] struct {
 	current   R
 	extractor ItemExtractor[R, I]
 	config    *ServiceConfig
+	filter    func(I) bool
+	stopWhen  func(I) bool
+	onPage    func(PageProgress)
 }
 
 // NewPageIterator creates an iterator from an initial response
@@ -115,6 +143,26 @@ func (it *PageIterator[R, I]) WithConfig(config *ServiceConfig) *PageIterator[R,
 	return it
 }
 
+// WithFilter keeps only items for which keep returns true. Filtering happens client side, so
+// filtered out items still count towards fetched pages but not towards MaxItems.
+func (it *PageIterator[R, I]) WithFilter(keep func(I) bool) *PageIterator[R, I] {
+	it.filter = keep
+	return it
+}
+
+// WithStopWhen ends iteration at the first item for which stop returns true. That item and any
+// after it are dropped and no further pages are fetched. Useful to end a date-sorted scan at a cutoff.
+func (it *PageIterator[R, I]) WithStopWhen(stop func(I) bool) *PageIterator[R, I] {
+	it.stopWhen = stop
+	return it
+}
+
+// WithOnPage sets a progress callback, overriding ServiceConfig.OnPage for this iterator
+func (it *PageIterator[R, I]) WithOnPage(fn func(PageProgress)) *PageIterator[R, I] {
+	it.onPage = fn
+	return it
+}
+
 // Current returns the current page response
 func (it *PageIterator[R, I]) Current() R {
 	return it.current
@@ -141,13 +189,23 @@ func (it *PageIterator[R, I]) Next(ctx context.Context) (R, error) {
 }
 
 // FetchAll retrieves all items across all pages starting from current page.
-// Respects MaxPages and MaxItems from config if set.
+// Respects MaxPages and MaxItems from config if set, along with any filter and stop condition.
 func (it *PageIterator[R, I]) FetchAll(ctx context.Context) ([]I, error) {
+	start := time.Now()
 	all := make([]I, 0)
-	all = append(all, it.Items()...)
+	fetched := 0
 
 	pages := 1
-	for it.HasNext() {
+	for {
+		items := it.Items()
+		fetched += len(items)
+		kept, stop := it.visit(items)
+		all = append(all, kept...)
+		it.progress(PageProgress{Page: pages, Items: len(all), Fetched: fetched, Elapsed: time.Since(start)})
+
+		if stop || !it.HasNext() {
+			break
+		}
 		// Check MaxPages limit
 		if it.config != nil && it.config.MaxPages > 0 && pages >= it.config.MaxPages {
 			break
@@ -161,7 +219,6 @@ func (it *PageIterator[R, I]) FetchAll(ctx context.Context) ([]I, error) {
 		if err != nil {
 			return all, err
 		}
-		all = append(all, it.Items()...)
 		pages++
 	}
 
@@ -174,28 +231,73 @@ func (it *PageIterator[R, I]) FetchAll(ctx context.Context) ([]I, error) {
 }
 
 // ForEach iterates through all pages starting from current, calling fn for each page.
-// Respects MaxPages from config if set.
+// Respects MaxPages from config if set, along with any filter and stop condition: pages on which
+// no item passes are skipped and iteration ends after the page containing an item that matches
+// the stop condition. Pages are passed to fn whole; use ForEachItems for the items that passed.
 func (it *PageIterator[R, I]) ForEach(ctx context.Context, fn func(R) error) error {
-	if err := fn(it.current); err != nil {
-		return err
-	}
+	return it.ForEachItems(ctx, func(page R, _ []I) error {
+		return fn(page)
+	})
+}
+
+// ForEachItems is ForEach with the items of each page that passed the filter and stop condition.
+func (it *PageIterator[R, I]) ForEachItems(ctx context.Context, fn func(R, []I) error) error {
+	start := time.Now()
+	kept, fetched := 0, 0
 
 	pages := 1
-	for it.HasNext() {
+	for {
+		items := it.Items()
+		fetched += len(items)
+		keptItems, stop := it.visit(items)
+		kept += len(keptItems)
+
+		if len(keptItems) > 0 || len(items) == 0 {
+			if err := fn(it.current, keptItems); err != nil {
+				return err
+			}
+		}
+		it.progress(PageProgress{Page: pages, Items: kept, Fetched: fetched, Elapsed: time.Since(start)})
+
+		if stop || !it.HasNext() {
+			return nil
+		}
 		// Check MaxPages limit
 		if it.config != nil && it.config.MaxPages > 0 && pages >= it.config.MaxPages {
-			break
+			return nil
 		}
 
 		_, err := it.Next(ctx)
 		if err != nil {
 			return err
 		}
-		if err := fn(it.current); err != nil {
-			return err
-		}
 		pages++
 	}
+}
+
+// visit applies the filter and stop condition to a page of items. It returns the kept items and
+// whether the stop condition was met.
+func (it *PageIterator[R, I]) visit(items []I) ([]I, bool) {
+	if it.filter == nil && it.stopWhen == nil {
+		return items, false
+	}
 
-	return nil
+	kept := make([]I, 0, len(items))
+	for _, item := range items {
+		if it.stopWhen != nil && it.stopWhen(item) {
+			return kept, true
+		}
+		if it.filter == nil || it.filter(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept, false
+}
+
+func (it *PageIterator[R, I]) progress(p PageProgress) {
+	if it.onPage != nil {
+		it.onPage(p)
+	} else if it.config != nil && it.config.OnPage != nil {
+		it.config.OnPage(p)
+	}
 }
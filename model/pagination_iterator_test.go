@@ -0,0 +1,153 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestFetchAllLimits(t *testing.T) {
+	cases := []struct {
+		description string
+		config      *ServiceConfig
+		expected    int
+		fetches     int
+	}{
+		{"NoLimits", nil, 10, 4},
+		{"MaxPages", &ServiceConfig{MaxPages: 2}, 6, 2},
+		{"MaxItems", &ServiceConfig{MaxItems: 4}, 4, 2},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			pager := &fakePager{total: 10, pageSize: 3}
+			items, err := newFakeIterator(t, pager).WithConfig(tt.config).FetchAll(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(items) != tt.expected || pager.fetches != tt.fetches {
+				t.Errorf("expected %d items in %d fetches, got %d in %d", tt.expected, tt.fetches, len(items), pager.fetches)
+			}
+		})
+	}
+}
+
+func TestFetchAllFilterAndStopWhen(t *testing.T) {
+	pager := &fakePager{total: 30, pageSize: 4}
+	items, err := newFakeIterator(t, pager).
+		WithFilter(func(i int) bool { return i%2 == 0 }).
+		WithStopWhen(func(i int) bool { return i >= 9 }).
+		FetchAll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fmt.Sprint(items) != "[0 2 4 6 8]" {
+		t.Errorf("unexpected items: %v", items)
+	}
+	if pager.fetches != 3 {
+		t.Errorf("expected stop condition to end paging after 3 fetches, got %d", pager.fetches)
+	}
+}
+
+func TestOnPageProgress(t *testing.T) {
+	var progress []PageProgress
+	config := &ServiceConfig{OnPage: func(p PageProgress) { progress = append(progress, p) }}
+
+	_, err := newFakeIterator(t, &fakePager{total: 7, pageSize: 3}).
+		WithConfig(config).
+		WithFilter(func(i int) bool { return i != 4 }).
+		FetchAll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(progress) != 3 {
+		t.Fatalf("expected 3 progress callbacks, got %d", len(progress))
+	}
+	last := progress[2]
+	if last.Page != 3 || last.Items != 6 || last.Fetched != 7 {
+		t.Errorf("unexpected final progress: %+v", last)
+	}
+
+	var overridden int
+	err = newFakeIterator(t, &fakePager{total: 7, pageSize: 3}).
+		WithConfig(config).
+		WithOnPage(func(p PageProgress) { overridden++ }).
+		ForEach(context.Background(), func(*fakeResponse) error { return nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if overridden != 3 || len(progress) != 3 {
+		t.Errorf("expected iterator callback to override config, got %d and %d", overridden, len(progress))
+	}
+}
+
+func TestForEachStopWhen(t *testing.T) {
+	pager := &fakePager{total: 30, pageSize: 5}
+	pages := 0
+	err := newFakeIterator(t, pager).
+		WithStopWhen(func(i int) bool { return i == 12 }).
+		ForEach(context.Background(), func(*fakeResponse) error {
+			pages++
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pages != 3 || pager.fetches != 3 {
+		t.Errorf("expected to stop after page 3, got %d pages and %d fetches", pages, pager.fetches)
+	}
+}
+
+func TestForEachFilter(t *testing.T) {
+	pager := &fakePager{total: 12, pageSize: 4}
+	var pages, items []int
+	err := newFakeIterator(t, pager).
+		WithFilter(func(i int) bool { return i >= 6 && i%2 == 0 }).
+		ForEachItems(context.Background(), func(page *fakeResponse, kept []int) error {
+			pages = append(pages, page.Items[0])
+			items = append(items, kept...)
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(pages, []int{4, 8}) {
+		t.Errorf("expected the page without matches to be skipped, got pages starting at %v", pages)
+	}
+	if !slices.Equal(items, []int{6, 8, 10}) {
+		t.Errorf("expected filtered items, got %v", items)
+	}
+
+	calls := 0
+	err = newFakeIterator(t, &fakePager{total: 12, pageSize: 4}).
+		WithFilter(func(i int) bool { return i == 9 }).
+		ForEach(context.Background(), func(*fakeResponse) error {
+			calls++
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected only the page with a match, got %d calls", calls)
+	}
+}
@@ -0,0 +1,95 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// PageInfo describes the page a streamed item was read from
+type PageInfo struct {
+	// Index is the 1-based page number within the stream
+	Index int
+	// Cursor is the cursor that fetched the page (empty for the first page)
+	Cursor string
+	// NextCursor is the cursor of the following page (empty on the last page)
+	NextCursor string
+}
+
+// StreamItem is an item delivered by Stream along with its page metadata
+type StreamItem[I any] struct {
+	Item I
+	Page PageInfo
+}
+
+// Stream pages through results in a background goroutine, sending every item on the returned channel.
+// The producer blocks while the consumer is behind by more than buffer items, so at most one page is
+// fetched ahead. Both channels are closed when the producer stops; the error channel receives at most
+// one value, either a fetch error or the context error on cancellation. Respects MaxPages and MaxItems
+// from config if set, along with any filter and stop condition.
+func (it *PageIterator[R, I]) Stream(ctx context.Context, buffer int) (<-chan StreamItem[I], <-chan error) {
+	out := make(chan StreamItem[I], buffer)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(out)
+
+		start := time.Now()
+		sent, fetched := 0, 0
+		page := PageInfo{Index: 1, NextCursor: it.current.GetNextCursor()}
+		for {
+			items := it.Items()
+			fetched += len(items)
+			kept, stop := it.visit(items)
+			for _, item := range kept {
+				if it.config != nil && it.config.MaxItems > 0 && sent >= it.config.MaxItems {
+					return
+				}
+				select {
+				case out <- StreamItem[I]{Item: item, Page: page}:
+					sent++
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+			it.progress(PageProgress{Page: page.Index, Items: sent, Fetched: fetched, Elapsed: time.Since(start)})
+
+			if stop || !it.HasNext() {
+				return
+			}
+			if it.config != nil && it.config.MaxPages > 0 && page.Index >= it.config.MaxPages {
+				return
+			}
+			if err := ctx.Err(); err != nil {
+				errs <- err
+				return
+			}
+
+			cursor := it.current.GetNextCursor()
+			if _, err := it.Next(ctx); err != nil {
+				errs <- err
+				return
+			}
+			page = PageInfo{Index: page.Index + 1, Cursor: cursor, NextCursor: it.current.GetNextCursor()}
+		}
+	}()
+
+	return out, errs
+}
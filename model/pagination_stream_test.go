@@ -0,0 +1,100 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func newFakeIterator(t *testing.T, p *fakePager) *PageIterator[*fakeResponse, int] {
+	first, err := p.list(context.Background(), &fakeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewPageIterator(first, func(r *fakeResponse) []int { return r.Items })
+}
+
+func TestStreamDeliversAllItemsWithPageInfo(t *testing.T) {
+	items, errs := newFakeIterator(t, &fakePager{total: 7, pageSize: 3}).Stream(context.Background(), 0)
+
+	var got []StreamItem[int]
+	for item := range items {
+		got = append(got, item)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(got) != 7 {
+		t.Fatalf("expected 7 items, got %d", len(got))
+	}
+	last := got[6]
+	if last.Item != 6 || last.Page.Index != 3 || last.Page.Cursor != "2" || last.Page.NextCursor != "" {
+		t.Errorf("unexpected last item: %+v", last)
+	}
+	if got[0].Page.Index != 1 || got[0].Page.Cursor != "" || got[0].Page.NextCursor != "1" {
+		t.Errorf("unexpected first item: %+v", got[0])
+	}
+}
+
+func TestStreamStopsOnCancel(t *testing.T) {
+	pager := &fakePager{total: 100, pageSize: 10}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	items, errs := newFakeIterator(t, pager).Stream(ctx, 0)
+	<-items
+	cancel()
+
+	for range items {
+	}
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if pager.fetches > 2 {
+		t.Errorf("expected producer to stop paging after cancel, got %d fetches", pager.fetches)
+	}
+}
+
+func TestStreamSurfacesFetchError(t *testing.T) {
+	items, errs := newFakeIterator(t, &fakePager{total: 10, pageSize: 3, failAt: 2}).Stream(context.Background(), 10)
+
+	count := 0
+	for range items {
+		count++
+	}
+	if err := <-errs; err == nil {
+		t.Fatal("expected fetch error")
+	}
+	if count != 6 {
+		t.Errorf("expected 6 items before failure, got %d", count)
+	}
+}
+
+func TestStreamRespectsMaxItems(t *testing.T) {
+	it := newFakeIterator(t, &fakePager{total: 10, pageSize: 3}).WithConfig(&ServiceConfig{MaxItems: 4})
+	items, errs := it.Stream(context.Background(), 0)
+
+	count := 0
+	for range items {
+		count++
+	}
+	if err := <-errs; err != nil || count != 4 {
+		t.Errorf("expected 4 items and no error, got %d and %v", count, err)
+	}
+}
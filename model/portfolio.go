@@ -16,8 +16,6 @@
 
 package model
 
-import "time"
-
 // Portfolio represents a Prime portfolio
 type Portfolio struct {
 	Id             string `json:"id"`
@@ -36,7 +34,7 @@ type Counterparty struct {
 type PostTradeCreditAmountDue struct {
 	Currency string    `json:"currency"`
 	Amount   string    `json:"amount"`
-	DueDate  time.Time `json:"due_date"`
+	DueDate  Timestamp `json:"due_date"`
 }
 
 // PostTradeCredit represents post trade credit information for a portfolio
@@ -16,13 +16,6 @@
 
 package model
 
-import (
-	"fmt"
-
-	"github.com/coinbase-samples/core-go"
-	"github.com/shopspring/decimal"
-)
-
 // ProductType represents the general type of product.
 type ProductType string
 
@@ -82,37 +75,37 @@ const (
 
 // FcmScheduledMaintenance contains scheduled maintenance window information.
 type FcmScheduledMaintenance struct {
-	StartTime string `json:"start_time,omitempty"`
-	EndTime   string `json:"end_time,omitempty"`
+	StartTime Timestamp `json:"start_time,omitzero"`
+	EndTime   Timestamp `json:"end_time,omitzero"`
 }
 
 // FcmTradingSessionDetails contains trading session details for FCM products.
 type FcmTradingSessionDetails struct {
 	SessionOpen                  bool                          `json:"session_open"`
-	OpenTime                     string                        `json:"open_time,omitempty"`
-	CloseTime                    string                        `json:"close_time,omitempty"`
+	OpenTime                     Timestamp                     `json:"open_time,omitzero"`
+	CloseTime                    Timestamp                     `json:"close_time,omitzero"`
 	SessionState                 FcmTradingSessionState        `json:"session_state,omitempty"`
 	AfterHoursOrderEntryDisabled bool                          `json:"after_hours_order_entry_disabled"`
 	ClosedReason                 FcmTradingSessionClosedReason `json:"closed_reason,omitempty"`
 	Maintenance                  *FcmScheduledMaintenance      `json:"maintenance,omitempty"`
-	SettlementTimestamp          string                        `json:"settlement_timestamp,omitempty"`
+	SettlementTimestamp          Timestamp                     `json:"settlement_timestamp,omitzero"`
 	SettlementPrice              string                        `json:"settlement_price,omitempty"`
 }
 
 // PerpetualProductDetails contains details specific to perpetual futures products.
 type PerpetualProductDetails struct {
-	OpenInterest   string `json:"open_interest,omitempty"`
-	FundingRate    string `json:"funding_rate,omitempty"`
-	FundingTime    string `json:"funding_time,omitempty"`
-	MaxLeverage    string `json:"max_leverage,omitempty"`
-	UnderlyingType string `json:"underlying_type,omitempty"`
+	OpenInterest   string    `json:"open_interest,omitempty"`
+	FundingRate    string    `json:"funding_rate,omitempty"`
+	FundingTime    Timestamp `json:"funding_time,omitzero"`
+	MaxLeverage    string    `json:"max_leverage,omitempty"`
+	UnderlyingType string    `json:"underlying_type,omitempty"`
 }
 
 // FutureProductDetails contains details specific to futures products.
 type FutureProductDetails struct {
 	ContractCode           string                   `json:"contract_code,omitempty"`
 	ContractSize           string                   `json:"contract_size,omitempty"`
-	ContractExpiry         string                   `json:"contract_expiry,omitempty"`
+	ContractExpiry         Timestamp                `json:"contract_expiry,omitzero"`
 	ContractRootUnit       string                   `json:"contract_root_unit,omitempty"`
 	ContractExpiryType     ContractExpiryType       `json:"contract_expiry_type,omitempty"`
 	RiskManagedBy          RiskManagementType       `json:"risk_managed_by,omitempty"`
@@ -151,54 +144,6 @@ type Product struct {
 	FutureProductDetails     *FutureProductDetails     `json:"future_product_details,omitempty"`
 }
 
-func (p Product) BaseMinSizeNum() (amount decimal.Decimal, err error) {
-	amount, err = core.StrToNum(p.BaseMinSize)
-	if err != nil {
-		err = fmt.Errorf("invalid base min: %s - id: %s - err: %w", p.BaseMinSize, p.Id, err)
-	}
-	return
-}
-
-func (p Product) BaseMaxSizeNum() (amount decimal.Decimal, err error) {
-	amount, err = core.StrToNum(p.BaseMaxSize)
-	if err != nil {
-		err = fmt.Errorf("invalid base max: %s - id: %s - err: %v", p.BaseMaxSize, p.Id, err)
-	}
-	return
-}
-
-func (p Product) BaseIncrementNum() (amount decimal.Decimal, err error) {
-	amount, err = core.StrToNum(p.BaseIncrement)
-	if err != nil {
-		err = fmt.Errorf("invalid base increment: %s - id: %s - msg: %w", p.BaseIncrement, p.Id, err)
-	}
-	return
-}
-
-func (p Product) QuoteMinSizeNum() (amount decimal.Decimal, err error) {
-	amount, err = core.StrToNum(p.QuoteMinSize)
-	if err != nil {
-		err = fmt.Errorf("invalid quote min: %s - id: %s - err: %w", p.QuoteMinSize, p.Id, err)
-	}
-	return
-}
-
-func (p Product) QuoteMaxSizeNum() (amount decimal.Decimal, err error) {
-	amount, err = core.StrToNum(p.QuoteMaxSize)
-	if err != nil {
-		err = fmt.Errorf("invalid quote max: %s - id: %s - err: %v", p.QuoteMaxSize, p.Id, err)
-	}
-	return
-}
-
-func (p Product) QuoteIncrementNum() (amount decimal.Decimal, err error) {
-	amount, err = core.StrToNum(p.QuoteIncrement)
-	if err != nil {
-		err = fmt.Errorf("invalid quote increment: %s - id: %s - msg: %w", p.QuoteIncrement, p.Id, err)
-	}
-	return
-}
-
 type CandleGranularity string
 
 const (
@@ -214,10 +159,10 @@ const (
 )
 
 type Candle struct {
-	Timestamp string `json:"timestamp"`
-	Open      string `json:"open"`
-	High      string `json:"high"`
-	Low       string `json:"low"`
-	Close     string `json:"close"`
-	Volume    string `json:"volume"`
+	Timestamp Timestamp `json:"timestamp"`
+	Open      string    `json:"open"`
+	High      string    `json:"high"`
+	Low       string    `json:"low"`
+	Close     string    `json:"close"`
+	Volume    string    `json:"volume"`
 }
@@ -78,18 +78,18 @@ const (
 type StakingStatus struct {
 	Amount                string    `json:"amount"`
 	StakeType             StakeType `json:"stake_type"`
-	EstimatedStakeDate    string    `json:"estimated_stake_date"`
+	EstimatedStakeDate    Timestamp `json:"estimated_stake_date"`
 	EstimatedHoursToStake int64     `json:"estimated_hours_to_stake"`
-	RequestedAt           string    `json:"requested_at"`
+	RequestedAt           Timestamp `json:"requested_at"`
 }
 
 // UnstakingStatus represents the status of an unstaking operation (from API spec)
 type UnstakingStatus struct {
 	Amount              string       `json:"amount"`
 	UnstakeType         UnstakeType  `json:"unstake_type"`
-	FinishingAt         string       `json:"finishing_at"`
+	FinishingAt         Timestamp    `json:"finishing_at"`
 	RemainingHours      int64        `json:"remaining_hours"`
-	RequestedAt         string       `json:"requested_at"`
+	RequestedAt         Timestamp    `json:"requested_at"`
 	EstimateType        EstimateType `json:"estimate_type"`
 	EstimateDescription string       `json:"estimate_description"`
 }
@@ -100,9 +100,9 @@ type UnstakeStatus struct {
 	EstimateType        EstimateType `json:"estimate_type"`
 	EstimateDescription string       `json:"estimate_description"`
 	UnstakeType         UnstakeType  `json:"unstake_type"`
-	FinishingAt         string       `json:"finishing_at"`
+	FinishingAt         Timestamp    `json:"finishing_at"`
 	RemainingHours      int          `json:"remaining_hours"`
-	RequestedAt         string       `json:"requested_at"`
+	RequestedAt         Timestamp    `json:"requested_at"`
 }
 
 // ValidatorStakingInfo represents staking information for a validator
@@ -137,8 +137,8 @@ type PortfolioStakingMetadata struct {
 
 // ValidatorUnstakePreview contains the per-validator breakdown for an unstake preview.
 type ValidatorUnstakePreview struct {
-	ValidatorAddress           string  `json:"validator_address,omitempty"`
-	EstimatedUnstakingAmount   string  `json:"estimated_unstaking_amount,omitempty"`
-	UnstakeTimeEstimateInHours float64 `json:"unstake_time_estimate_in_hours,omitempty"`
-	EstimatedUnstakeDate       string  `json:"estimated_unstake_date,omitempty"`
+	ValidatorAddress           string    `json:"validator_address,omitempty"`
+	EstimatedUnstakingAmount   string    `json:"estimated_unstaking_amount,omitempty"`
+	UnstakeTimeEstimateInHours float64   `json:"unstake_time_estimate_in_hours,omitempty"`
+	EstimatedUnstakeDate       Timestamp `json:"estimated_unstake_date,omitzero"`
 }
@@ -0,0 +1,77 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestampUnmarshal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{`"2026-03-01T12:00:00Z"`, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{`"2026-03-01T12:00:00.123456789Z"`, time.Date(2026, 3, 1, 12, 0, 0, 123456789, time.UTC)},
+		{`"2026-03-01T14:00:00+02:00"`, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{`"2026-03-01T12:00:00"`, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{`"2026-03-01"`, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{`""`, time.Time{}},
+		{`null`, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(tt.in), &ts); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.in, err)
+		}
+		if !ts.Equal(tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.in, tt.want, ts.Time)
+		}
+	}
+
+	var ts Timestamp
+	if err := json.Unmarshal([]byte(`"yesterday"`), &ts); err == nil {
+		t.Error("expected error for invalid timestamp")
+	}
+}
+
+func TestTimestampMarshal(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	order := Order{StartTime: NewTimestamp(time.Date(2026, 3, 1, 14, 0, 0, 500, loc))}
+
+	b, err := json.Marshal(order)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded["start_time"] != "2026-03-01T12:00:00.0000005Z" {
+		t.Errorf("unexpected start_time: %v", decoded["start_time"])
+	}
+	if _, ok := decoded["expiry_time"]; ok {
+		t.Error("expected zero expiry_time to be omitted")
+	}
+	if decoded["created_at"] != nil {
+		t.Errorf("unexpected created_at: %v", decoded["created_at"])
+	}
+}
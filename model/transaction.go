@@ -18,12 +18,126 @@ package model
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/coinbase-samples/core-go"
 	"github.com/shopspring/decimal"
 )
 
+// TransactionStatus is the state of a transaction
+type TransactionStatus string
+
+const (
+	TransactionStatusCreated       TransactionStatus = "TRANSACTION_CREATED"
+	TransactionStatusRequested     TransactionStatus = "TRANSACTION_REQUESTED"
+	TransactionStatusApproved      TransactionStatus = "TRANSACTION_APPROVED"
+	TransactionStatusGassing       TransactionStatus = "TRANSACTION_GASSING"
+	TransactionStatusGassed        TransactionStatus = "TRANSACTION_GASSED"
+	TransactionStatusProvisioned   TransactionStatus = "TRANSACTION_PROVISIONED"
+	TransactionStatusPlanned       TransactionStatus = "TRANSACTION_PLANNED"
+	TransactionStatusProcessing    TransactionStatus = "TRANSACTION_PROCESSING"
+	TransactionStatusRestored      TransactionStatus = "TRANSACTION_RESTORED"
+	TransactionStatusDone          TransactionStatus = "TRANSACTION_DONE"
+	TransactionStatusImportPending TransactionStatus = "TRANSACTION_IMPORT_PENDING"
+	TransactionStatusImported      TransactionStatus = "TRANSACTION_IMPORTED"
+	TransactionStatusCancelled     TransactionStatus = "TRANSACTION_CANCELLED"
+	TransactionStatusRejected      TransactionStatus = "TRANSACTION_REJECTED"
+	TransactionStatusDelayed       TransactionStatus = "TRANSACTION_DELAYED"
+	TransactionStatusRetried       TransactionStatus = "TRANSACTION_RETRIED"
+	TransactionStatusFailed        TransactionStatus = "TRANSACTION_FAILED"
+	TransactionStatusExpired       TransactionStatus = "TRANSACTION_EXPIRED"
+	TransactionStatusBroadcasting  TransactionStatus = "TRANSACTION_BROADCASTING"
+	TransactionStatusOther         TransactionStatus = "OTHER_TRANSACTION_STATUS"
+	TransactionStatusConstructed   TransactionStatus = "TRANSACTION_CONSTRUCTED"
+)
+
+// IsValid returns true if s is a transaction status listed in the API spec
+func (s TransactionStatus) IsValid() bool {
+	switch s {
+	case TransactionStatusCreated, TransactionStatusRequested, TransactionStatusApproved,
+		TransactionStatusGassing, TransactionStatusGassed, TransactionStatusProvisioned,
+		TransactionStatusPlanned, TransactionStatusProcessing, TransactionStatusRestored,
+		TransactionStatusDone, TransactionStatusImportPending, TransactionStatusImported,
+		TransactionStatusCancelled, TransactionStatusRejected, TransactionStatusDelayed,
+		TransactionStatusRetried, TransactionStatusFailed, TransactionStatusExpired,
+		TransactionStatusBroadcasting, TransactionStatusOther, TransactionStatusConstructed:
+		return true
+	}
+	return false
+}
+
+// IsTerminal returns true for statuses a transaction never leaves: DONE, IMPORTED, CANCELLED,
+// REJECTED, RETRIED, FAILED and EXPIRED. A RETRIED transaction is replaced by a new one.
+func (s TransactionStatus) IsTerminal() bool {
+	switch s {
+	case TransactionStatusDone, TransactionStatusImported, TransactionStatusCancelled,
+		TransactionStatusRejected, TransactionStatusRetried, TransactionStatusFailed,
+		TransactionStatusExpired:
+		return true
+	}
+	return false
+}
+
+// TransactionType is the kind of a transaction
+type TransactionType string
+
+const (
+	TransactionTypeDeposit                TransactionType = "DEPOSIT"
+	TransactionTypeWithdrawal             TransactionType = "WITHDRAWAL"
+	TransactionTypeInternalDeposit        TransactionType = "INTERNAL_DEPOSIT"
+	TransactionTypeInternalWithdrawal     TransactionType = "INTERNAL_WITHDRAWAL"
+	TransactionTypeSweepDeposit           TransactionType = "SWEEP_DEPOSIT"
+	TransactionTypeSweepWithdrawal        TransactionType = "SWEEP_WITHDRAWAL"
+	TransactionTypeProxyDeposit           TransactionType = "PROXY_DEPOSIT"
+	TransactionTypeProxyWithdrawal        TransactionType = "PROXY_WITHDRAWAL"
+	TransactionTypeBillingWithdrawal      TransactionType = "BILLING_WITHDRAWAL"
+	TransactionTypeReward                 TransactionType = "REWARD"
+	TransactionTypeCoinbaseRefund         TransactionType = "COINBASE_REFUND"
+	TransactionTypeOther                  TransactionType = "TRANSACTION_TYPE_OTHER"
+	TransactionTypeWithdrawalAdjustment   TransactionType = "WITHDRAWAL_ADJUSTMENT"
+	TransactionTypeDepositAdjustment      TransactionType = "DEPOSIT_ADJUSTMENT"
+	TransactionTypeKeyRegistration        TransactionType = "KEY_REGISTRATION"
+	TransactionTypeDelegation             TransactionType = "DELEGATION"
+	TransactionTypeUndelegation           TransactionType = "UNDELEGATION"
+	TransactionTypeRestake                TransactionType = "RESTAKE"
+	TransactionTypeCompleteUnbonding      TransactionType = "COMPLETE_UNBONDING"
+	TransactionTypeWithdrawUnbonded       TransactionType = "WITHDRAW_UNBONDED"
+	TransactionTypeStakeAccountCreate     TransactionType = "STAKE_ACCOUNT_CREATE"
+	TransactionTypeChangeValidator        TransactionType = "CHANGE_VALIDATOR"
+	TransactionTypeStake                  TransactionType = "STAKE"
+	TransactionTypeUnstake                TransactionType = "UNSTAKE"
+	TransactionTypeRemoveAuthorizedParty  TransactionType = "REMOVE_AUTHORIZED_PARTY"
+	TransactionTypeStakeAuthorizeWithSeed TransactionType = "STAKE_AUTHORIZE_WITH_SEED"
+	TransactionTypeSlash                  TransactionType = "SLASH"
+	TransactionTypeCoinbaseDeposit        TransactionType = "COINBASE_DEPOSIT"
+	TransactionTypeConversion             TransactionType = "CONVERSION"
+	TransactionTypeClaimRewards           TransactionType = "CLAIM_REWARDS"
+	TransactionTypeVoteAuthorize          TransactionType = "VOTE_AUTHORIZE"
+	TransactionTypeWeb3Transaction        TransactionType = "WEB3_TRANSACTION"
+	TransactionTypeOnchainTransaction     TransactionType = "ONCHAIN_TRANSACTION"
+	TransactionTypePortfolioStake         TransactionType = "PORTFOLIO_STAKE"
+	TransactionTypePortfolioUnstake       TransactionType = "PORTFOLIO_UNSTAKE"
+)
+
+// IsValid returns true if t is a transaction type listed in the API spec
+func (t TransactionType) IsValid() bool {
+	switch t {
+	case TransactionTypeDeposit, TransactionTypeWithdrawal, TransactionTypeInternalDeposit,
+		TransactionTypeInternalWithdrawal, TransactionTypeSweepDeposit, TransactionTypeSweepWithdrawal,
+		TransactionTypeProxyDeposit, TransactionTypeProxyWithdrawal, TransactionTypeBillingWithdrawal,
+		TransactionTypeReward, TransactionTypeCoinbaseRefund, TransactionTypeOther,
+		TransactionTypeWithdrawalAdjustment, TransactionTypeDepositAdjustment, TransactionTypeKeyRegistration,
+		TransactionTypeDelegation, TransactionTypeUndelegation, TransactionTypeRestake,
+		TransactionTypeCompleteUnbonding, TransactionTypeWithdrawUnbonded, TransactionTypeStakeAccountCreate,
+		TransactionTypeChangeValidator, TransactionTypeStake, TransactionTypeUnstake,
+		TransactionTypeRemoveAuthorizedParty, TransactionTypeStakeAuthorizeWithSeed, TransactionTypeSlash,
+		TransactionTypeCoinbaseDeposit, TransactionTypeConversion, TransactionTypeClaimRewards,
+		TransactionTypeVoteAuthorize, TransactionTypeWeb3Transaction, TransactionTypeOnchainTransaction,
+		TransactionTypePortfolioStake, TransactionTypePortfolioUnstake:
+		return true
+	}
+	return false
+}
+
 // TravelRuleWalletType represents the type of wallet for travel rule compliance
 type TravelRuleWalletType string
 
@@ -78,7 +192,9 @@ type EstimatedNetworkFees struct {
 
 // MatchMetadata represents metadata for matched transactions
 type MatchMetadata struct {
-	ReferenceId    string `json:"reference_id,omitempty"`
+	ReferenceId string `json:"reference_id,omitempty"`
+	// SettlementDate is the settlement date of the blind match advanced transfer, in the compact
+	// SettlementDateLayout it was submitted in, so it stays a string like BlindMatchMetadata
 	SettlementDate string `json:"settlement_date,omitempty"`
 }
 
@@ -181,11 +297,11 @@ type Transaction struct {
 	Id                    string                `json:"id"`
 	WalletId              string                `json:"wallet_id"`
 	PortfolioId           string                `json:"portfolio_id"`
-	Type                  string                `json:"type"`
-	Status                string                `json:"status"`
+	Type                  TransactionType       `json:"type"`
+	Status                TransactionStatus     `json:"status"`
 	Symbol                string                `json:"symbol"`
-	Created               time.Time             `json:"created_at"`
-	Completed             time.Time             `json:"completed_at"`
+	Created               Timestamp             `json:"created_at"`
+	Completed             Timestamp             `json:"completed_at"`
 	Amount                string                `json:"amount"`
 	TransferFrom          *Transfer             `json:"transfer_from,omitempty"`
 	TransferTo            *Transfer             `json:"transfer_to,omitempty"`
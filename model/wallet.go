@@ -16,8 +16,6 @@
 
 package model
 
-import "time"
-
 // WalletVisibility represents the visibility state of a wallet
 type WalletVisibility string
 
@@ -50,7 +48,7 @@ type Wallet struct {
 	Address    string           `json:"address"`
 	Visibility WalletVisibility `json:"visibility"`
 	Symbol     string           `json:"symbol"`
-	Created    time.Time        `json:"created_at"`
+	Created    Timestamp        `json:"created_at"`
 	Network    *NetworkDetails  `json:"network"`
 }
 
@@ -0,0 +1,341 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package oms is a client-side order management system built on the orders service.
+// It tracks submitted orders by client order id, persists them through a pluggable Store,
+// and reconciles local state against Prime to surface discrepancies as events.
+package oms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/coinbase-samples/prime-sdk-go/utils"
+)
+
+const (
+	// StatusSubmitting is the local status of an order persisted before CreateOrder returned an order id
+	StatusSubmitting model.OrderStatus = "SUBMITTING"
+	// StatusUnknown is the local terminal status of a SUBMITTING order not found at Prime after
+	// PendingTimeout. It is revived if the order later shows up among the open or listed orders.
+	StatusUnknown model.OrderStatus = "UNKNOWN"
+)
+
+// TrackedOrder is the OMS view of an order
+type TrackedOrder struct {
+	ClientOrderId string `json:"client_order_id"`
+	// OrderId is empty until Prime acknowledges the order
+	OrderId     string       `json:"order_id"`
+	PortfolioId string       `json:"portfolio_id"`
+	Request     *model.Order `json:"request"`
+	// Status is StatusSubmitting, StatusUnknown or the last status reported by Prime
+	Status         model.OrderStatus  `json:"status"`
+	FilledQuantity string             `json:"filled_quantity"`
+	Fills          []*model.OrderFill `json:"fills,omitempty"`
+	SubmittedAt    time.Time          `json:"submitted_at"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+}
+
+// IsTerminal returns true once the order can no longer change, or was given up as StatusUnknown
+func (o *TrackedOrder) IsTerminal() bool {
+	return o.Status == StatusUnknown || o.Status.IsTerminal()
+}
+
+func (o *TrackedOrder) hasFill(id string) bool {
+	for _, f := range o.Fills {
+		if f.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *TrackedOrder) clone() *TrackedOrder {
+	cp := *o
+	cp.Fills = append([]*model.OrderFill(nil), o.Fills...)
+	return &cp
+}
+
+// EventType identifies what an Event reports
+type EventType string
+
+const (
+	// EventSubmitted is emitted when Prime acknowledges an order
+	EventSubmitted EventType = "SUBMITTED"
+	// EventSubmitFailed is emitted when CreateOrder fails. The order stays SUBMITTING until
+	// reconciliation finds it or reports it stuck, since the failure may have been ambiguous.
+	EventSubmitFailed EventType = "SUBMIT_FAILED"
+	// EventStatusChanged is emitted when reconciliation observes a new status
+	EventStatusChanged EventType = "STATUS_CHANGED"
+	// EventFill is emitted for each newly observed fill of a tracked order
+	EventFill EventType = "FILL"
+	// EventUnknownOpenOrder is emitted for open orders at Prime that the OMS does not track
+	EventUnknownOpenOrder EventType = "UNKNOWN_OPEN_ORDER"
+	// EventMissedFill is emitted when an order's filled quantity exceeds the fills observed for it
+	EventMissedFill EventType = "MISSED_FILL"
+	// EventStuckPendingSubmit is emitted when a SUBMITTING order is not found at Prime after
+	// PendingTimeout and is moved to StatusUnknown
+	EventStuckPendingSubmit EventType = "STUCK_PENDING_SUBMIT"
+	// EventReconcileFailed is emitted when a reconciliation pass fails
+	EventReconcileFailed EventType = "RECONCILE_FAILED"
+)
+
+// Event reports a change or discrepancy found by the OMS
+type Event struct {
+	Type    EventType
+	Time    time.Time
+	Order   *TrackedOrder
+	Remote  *model.Order
+	Fill    *model.OrderFill
+	Err     error
+	Message string
+}
+
+// Config controls the OMS
+type Config struct {
+	// PortfolioId is the portfolio whose orders are managed (required)
+	PortfolioId string
+	// Store persists tracked orders (default in-memory)
+	Store Store
+	// ReconcileInterval is how often Run reconciles (default 30s)
+	ReconcileInterval time.Duration
+	// PendingTimeout is how long an order may stay SUBMITTING before it is marked StatusUnknown (default 1m)
+	PendingTimeout time.Duration
+	// Lookback bounds how far back ListOrders and ListPortfolioFills are queried (default 24h)
+	Lookback time.Duration
+	// Retention is how long a terminal order stays tracked after its last change (default 1h).
+	// It is then pruned from memory and the store once all its fills were observed, or once it
+	// is older than Lookback and its missing fills can no longer be listed.
+	Retention time.Duration
+	// OnEvent receives every event. It is called synchronously and must not call back into the OMS.
+	OnEvent func(Event)
+}
+
+// OMS tracks orders submitted through it and reconciles them against Prime
+type OMS struct {
+	service     orders.OrdersService
+	config      Config
+	mu          sync.Mutex
+	orders      map[string]*TrackedOrder
+	reconcileMu sync.Mutex
+	now         func() time.Time
+}
+
+// New creates an OMS and restores previously tracked orders from the store.
+// Call Reconcile (or Run) after a restart to bring the restored orders up to date.
+func New(ctx context.Context, service orders.OrdersService, config *Config) (*OMS, error) {
+	if config == nil || len(config.PortfolioId) == 0 {
+		return nil, errors.New("oms portfolio id is required")
+	}
+
+	cfg := *config
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryStore()
+	}
+	if cfg.ReconcileInterval <= 0 {
+		cfg.ReconcileInterval = 30 * time.Second
+	}
+	if cfg.PendingTimeout <= 0 {
+		cfg.PendingTimeout = time.Minute
+	}
+	if cfg.Lookback <= 0 {
+		cfg.Lookback = 24 * time.Hour
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = time.Hour
+	}
+
+	restored, err := cfg.Store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load oms orders: %w", err)
+	}
+
+	o := &OMS{
+		service: service,
+		config:  cfg,
+		orders:  make(map[string]*TrackedOrder, len(restored)),
+		now:     time.Now,
+	}
+	for _, tracked := range restored {
+		o.orders[tracked.ClientOrderId] = tracked
+	}
+
+	return o, nil
+}
+
+// Submit tracks and places order. The order is persisted as SUBMITTING before CreateOrder is called,
+// so an order whose submission outcome is unknown is still reconciled after a crash. A client order
+// id is generated when empty. Submitting a client order id that is already tracked returns an error.
+func (o *OMS) Submit(ctx context.Context, order *model.Order) (*TrackedOrder, error) {
+	if order == nil {
+		return nil, errors.New("order not set")
+	}
+
+	req := *order
+	if len(req.PortfolioId) == 0 {
+		req.PortfolioId = o.config.PortfolioId
+	}
+	if req.PortfolioId != o.config.PortfolioId {
+		return nil, fmt.Errorf("order portfolio %s is not managed by this oms", req.PortfolioId)
+	}
+	if len(req.ClientOrderId) == 0 {
+		req.ClientOrderId = utils.NewUuid()
+	}
+
+	now := o.now()
+	tracked := &TrackedOrder{
+		ClientOrderId: req.ClientOrderId,
+		PortfolioId:   req.PortfolioId,
+		Request:       &req,
+		Status:        StatusSubmitting,
+		SubmittedAt:   now,
+		UpdatedAt:     now,
+	}
+
+	o.mu.Lock()
+	if _, ok := o.orders[tracked.ClientOrderId]; ok {
+		o.mu.Unlock()
+		return nil, fmt.Errorf("client order id %s is already tracked", tracked.ClientOrderId)
+	}
+	o.orders[tracked.ClientOrderId] = tracked
+	o.mu.Unlock()
+
+	if err := o.save(ctx, tracked); err != nil {
+		o.mu.Lock()
+		delete(o.orders, tracked.ClientOrderId)
+		o.mu.Unlock()
+		return nil, err
+	}
+
+	resp, err := o.service.CreateOrder(ctx, &orders.CreateOrderRequest{Order: &req})
+	if err != nil {
+		o.emit(Event{Type: EventSubmitFailed, Order: o.snapshot(tracked), Err: err})
+		return o.snapshot(tracked), err
+	}
+
+	o.mu.Lock()
+	tracked.OrderId = resp.OrderId
+	if tracked.Status == StatusSubmitting {
+		tracked.Status = model.OrderStatusPending
+	}
+	tracked.UpdatedAt = o.now()
+	o.mu.Unlock()
+
+	if err := o.save(ctx, tracked); err != nil {
+		return o.snapshot(tracked), err
+	}
+
+	o.emit(Event{Type: EventSubmitted, Order: o.snapshot(tracked)})
+	return o.snapshot(tracked), nil
+}
+
+// Cancel cancels a tracked order. The status is updated by the next reconciliation.
+func (o *OMS) Cancel(ctx context.Context, clientOrderId string) error {
+	tracked, ok := o.Order(clientOrderId)
+	if !ok {
+		return fmt.Errorf("client order id %s is not tracked", clientOrderId)
+	}
+	if len(tracked.OrderId) == 0 {
+		return fmt.Errorf("order %s has not been acknowledged yet", clientOrderId)
+	}
+
+	_, err := o.service.CancelOrder(ctx, &orders.CancelOrderRequest{
+		PortfolioId: tracked.PortfolioId,
+		OrderId:     tracked.OrderId,
+	})
+	return err
+}
+
+// Order returns a copy of a tracked order
+func (o *OMS) Order(clientOrderId string) (*TrackedOrder, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	tracked, ok := o.orders[clientOrderId]
+	if !ok {
+		return nil, false
+	}
+	return tracked.clone(), true
+}
+
+// Orders returns copies of all tracked orders, oldest first
+func (o *OMS) Orders() []*TrackedOrder {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	all := make([]*TrackedOrder, 0, len(o.orders))
+	for _, tracked := range o.orders {
+		all = append(all, tracked.clone())
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if !all[i].SubmittedAt.Equal(all[j].SubmittedAt) {
+			return all[i].SubmittedAt.Before(all[j].SubmittedAt)
+		}
+		return all[i].ClientOrderId < all[j].ClientOrderId
+	})
+	return all
+}
+
+// Run reconciles immediately and then every ReconcileInterval until ctx is done.
+// Failed passes are reported as EventReconcileFailed and retried on the next tick.
+func (o *OMS) Run(ctx context.Context) error {
+	ticker := time.NewTicker(o.config.ReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := o.Reconcile(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			o.emit(Event{Type: EventReconcileFailed, Err: err})
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (o *OMS) snapshot(tracked *TrackedOrder) *TrackedOrder {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return tracked.clone()
+}
+
+func (o *OMS) save(ctx context.Context, tracked *TrackedOrder) error {
+	if err := o.config.Store.Save(ctx, o.snapshot(tracked)); err != nil {
+		return fmt.Errorf("unable to persist order %s: %w", tracked.ClientOrderId, err)
+	}
+	return nil
+}
+
+func (o *OMS) emit(e Event) {
+	if o.config.OnEvent == nil {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = o.now()
+	}
+	o.config.OnEvent(e)
+}
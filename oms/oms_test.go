@@ -0,0 +1,308 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+)
+
+// fakePrime serves a mutable set of orders and fills through the OrdersService interface
+type fakePrime struct {
+	orders.OrdersService
+	mu        sync.Mutex
+	open      []*model.Order
+	closed    []*model.Order
+	fills     []*model.OrderFill
+	createErr error
+	nextId    int
+	// listOrders counts ListOrders calls
+	listOrders int
+}
+
+func (f *fakePrime) CreateOrder(ctx context.Context, r *orders.CreateOrderRequest) (*orders.CreateOrderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nextId++
+	order := *r.Order
+	order.Id = fmt.Sprintf("order-%d", f.nextId)
+	order.Status = model.OrderStatusOpen
+	f.open = append(f.open, &order)
+
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	return &orders.CreateOrderResponse{OrderId: order.Id, Request: r}, nil
+}
+
+func (f *fakePrime) ListOpenOrders(ctx context.Context, r *orders.ListOpenOrdersRequest) (*orders.ListOpenOrdersResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &orders.ListOpenOrdersResponse{Orders: append([]*model.Order(nil), f.open...)}, nil
+}
+
+func (f *fakePrime) ListOrders(ctx context.Context, r *orders.ListOrdersRequest) (*orders.ListOrdersResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listOrders++
+	return &orders.ListOrdersResponse{Orders: append([]*model.Order(nil), f.closed...)}, nil
+}
+
+func (f *fakePrime) ListPortfolioFills(ctx context.Context, r *orders.ListPortfolioFillsRequest) (*orders.ListPortfolioFillsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &orders.ListPortfolioFillsResponse{Fills: append([]*model.OrderFill(nil), f.fills...)}, nil
+}
+
+// fill closes an open order as FILLED with a single fill
+func (f *fakePrime) fill(orderId, qty string, recordFill bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, o := range f.open {
+		if o.Id == orderId {
+			o.Status = model.OrderStatusFilled
+			o.FilledQuantity = qty
+			f.closed = append(f.closed, o)
+			f.open = append(f.open[:i], f.open[i+1:]...)
+			break
+		}
+	}
+	if recordFill {
+		f.fills = append(f.fills, &model.OrderFill{Id: "fill-" + orderId, OrderId: orderId, FilledQuantity: qty})
+	}
+}
+
+type eventLog struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (l *eventLog) record(e Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, e)
+}
+
+func (l *eventLog) count(t EventType) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := 0
+	for _, e := range l.events {
+		if e.Type == t {
+			n++
+		}
+	}
+	return n
+}
+
+func newTestOms(t *testing.T, prime *fakePrime, store Store, log *eventLog) *OMS {
+	t.Helper()
+	o, err := New(context.Background(), prime, &Config{PortfolioId: "p1", Store: store, OnEvent: log.record})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return o
+}
+
+func TestSubmitAndReconcileFill(t *testing.T) {
+	ctx := context.Background()
+	prime := &fakePrime{}
+	log := &eventLog{}
+	o := newTestOms(t, prime, NewMemoryStore(), log)
+
+	tracked, err := o.Submit(ctx, &model.Order{ProductId: "BTC-USD", Side: "BUY", Type: model.OrderTypeMarket, BaseQuantity: "1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tracked.OrderId != "order-1" || tracked.ClientOrderId == "" || tracked.Status != model.OrderStatusPending {
+		t.Fatalf("unexpected tracked order: %+v", tracked)
+	}
+
+	prime.fill("order-1", "1", true)
+	if err := o.Reconcile(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, _ := o.Order(tracked.ClientOrderId)
+	if got.Status != model.OrderStatusFilled || len(got.Fills) != 1 || !got.IsTerminal() {
+		t.Errorf("unexpected order after reconcile: %+v", got)
+	}
+	if log.count(EventSubmitted) != 1 || log.count(EventStatusChanged) != 1 || log.count(EventFill) != 1 || log.count(EventMissedFill) != 0 {
+		t.Errorf("unexpected events: %+v", log.events)
+	}
+}
+
+func TestReconcileReportsDiscrepancies(t *testing.T) {
+	ctx := context.Background()
+	prime := &fakePrime{open: []*model.Order{{Id: "foreign", ClientOrderId: "someone-else", Status: model.OrderStatusOpen}}}
+	log := &eventLog{}
+	o := newTestOms(t, prime, NewMemoryStore(), log)
+
+	tracked, err := o.Submit(ctx, &model.Order{ClientOrderId: "c1", ProductId: "BTC-USD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Filled without the fill showing up in ListPortfolioFills
+	prime.fill(tracked.OrderId, "2", false)
+
+	if err := o.Reconcile(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if log.count(EventUnknownOpenOrder) != 1 {
+		t.Errorf("expected unknown open order event, got %+v", log.events)
+	}
+	if log.count(EventMissedFill) != 1 {
+		t.Errorf("expected missed fill event, got %+v", log.events)
+	}
+}
+
+func TestAmbiguousSubmitRecoversAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// CreateOrder times out but Prime accepted the order
+	prime := &fakePrime{createErr: errors.New("timeout")}
+	log := &eventLog{}
+	o := newTestOms(t, prime, store, log)
+
+	if _, err := o.Submit(ctx, &model.Order{ClientOrderId: "c1"}); err == nil {
+		t.Fatal("expected submit error")
+	}
+	if log.count(EventSubmitFailed) != 1 {
+		t.Fatalf("expected submit failed event, got %+v", log.events)
+	}
+
+	// Simulate a restart with a fresh OMS over the same directory
+	store2, _ := NewFileStore(dir)
+	log2 := &eventLog{}
+	restarted := newTestOms(t, prime, store2, log2)
+
+	restored, ok := restarted.Order("c1")
+	if !ok || restored.Status != StatusSubmitting {
+		t.Fatalf("expected SUBMITTING order to be restored, got %+v", restored)
+	}
+
+	if err := restarted.Reconcile(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := restarted.Order("c1")
+	if got.OrderId != "order-1" || got.Status != model.OrderStatusOpen {
+		t.Errorf("expected order to be matched by client order id, got %+v", got)
+	}
+	if log2.count(EventUnknownOpenOrder) != 0 {
+		t.Errorf("did not expect unknown open order, got %+v", log2.events)
+	}
+}
+
+func TestStuckPendingSubmitMarkedUnknown(t *testing.T) {
+	ctx := context.Background()
+	prime := &fakePrime{}
+	log := &eventLog{}
+	o := newTestOms(t, prime, NewMemoryStore(), log)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	o.now = func() time.Time { return now }
+
+	// The order never reached Prime
+	o.orders["lost"] = &TrackedOrder{ClientOrderId: "lost", PortfolioId: "p1", Status: StatusSubmitting, SubmittedAt: now}
+
+	now = now.Add(2 * time.Minute)
+	for i := 0; i < 2; i++ {
+		if err := o.Reconcile(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if log.count(EventStuckPendingSubmit) != 1 {
+		t.Errorf("expected one stuck event, got %+v", log.events)
+	}
+	if got, _ := o.Order("lost"); got.Status != StatusUnknown || !got.IsTerminal() {
+		t.Errorf("expected the order to be unknown, got %+v", got)
+	}
+	// Only the first pass looked for the order among closed orders
+	if prime.listOrders != 1 {
+		t.Errorf("expected 1 ListOrders call, got %d", prime.listOrders)
+	}
+}
+
+func TestReconcilePrunesTerminalOrders(t *testing.T) {
+	ctx := context.Background()
+	prime := &fakePrime{}
+	store := NewMemoryStore()
+	o := newTestOms(t, prime, store, &eventLog{})
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	o.now = func() time.Time { return now }
+
+	for _, id := range []string{"complete", "missing-fill"} {
+		if _, err := o.Submit(ctx, &model.Order{ClientOrderId: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	prime.fill("order-1", "1", true)
+	prime.fill("order-2", "1", false)
+
+	if err := o.Reconcile(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(o.Orders()) != 2 {
+		t.Fatalf("expected terminal orders to be kept for the retention period")
+	}
+
+	now = now.Add(2 * time.Hour)
+	if err := o.Reconcile(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := o.Order("complete"); ok {
+		t.Error("expected the fully filled order to be pruned")
+	}
+	if _, ok := o.Order("missing-fill"); !ok {
+		t.Error("expected the order with a missing fill to be kept within the lookback")
+	}
+	if stored, _ := store.Load(ctx); len(stored) != 1 {
+		t.Errorf("expected one stored order, got %d", len(stored))
+	}
+
+	now = now.Add(24 * time.Hour)
+	if err := o.Reconcile(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(o.Orders()) != 0 {
+		t.Errorf("expected every order to be pruned after the lookback, got %+v", o.Orders())
+	}
+}
+
+func TestNewRequiresPortfolio(t *testing.T) {
+	if _, err := New(context.Background(), &fakePrime{}, &Config{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,267 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/shopspring/decimal"
+)
+
+// Reconcile runs one reconciliation pass. Open orders come from ListOpenOrders, orders that closed
+// since the last pass from ListOrders, and fills from ListPortfolioFills, all bounded by Lookback.
+// Local state is updated and persisted, every change or discrepancy is emitted as an Event, and
+// terminal orders past Retention are pruned.
+func (o *OMS) Reconcile(ctx context.Context) error {
+	o.reconcileMu.Lock()
+	defer o.reconcileMu.Unlock()
+
+	now := o.now()
+	start := now.Add(-o.config.Lookback)
+
+	openResp, err := o.service.ListOpenOrders(ctx, &orders.ListOpenOrdersRequest{PortfolioId: o.config.PortfolioId})
+	if err != nil {
+		return fmt.Errorf("unable to list open orders: %w", err)
+	}
+
+	remote := newRemoteIndex(openResp.Orders)
+
+	if o.needsClosedOrders(remote) {
+		resp, err := o.service.ListOrders(ctx, &orders.ListOrdersRequest{
+			PortfolioId: o.config.PortfolioId,
+			Start:       start,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to list orders: %w", err)
+		}
+		closed, err := resp.Iterator().WithConfig(nil).FetchAll(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to list orders: %w", err)
+		}
+		remote.add(closed)
+	}
+
+	fillsResp, err := o.service.ListPortfolioFills(ctx, &orders.ListPortfolioFillsRequest{
+		PortfolioId: o.config.PortfolioId,
+		Start:       start,
+		End:         now,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list portfolio fills: %w", err)
+	}
+	fills, err := fillsResp.Iterator().WithConfig(nil).FetchAll(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list portfolio fills: %w", err)
+	}
+
+	var (
+		events  []Event
+		changed = make(map[string]*TrackedOrder)
+		known   = make(map[string]bool)
+	)
+
+	o.mu.Lock()
+	for _, tracked := range o.orders {
+		r := remote.find(tracked)
+		if r != nil {
+			known[r.Id] = true
+		}
+
+		// Unknown orders are revived if Prime reports them after all
+		if tracked.IsTerminal() && (tracked.Status != StatusUnknown || r == nil) {
+			continue
+		}
+
+		if r == nil {
+			if tracked.Status == StatusSubmitting && now.Sub(tracked.SubmittedAt) > o.config.PendingTimeout {
+				tracked.Status = StatusUnknown
+				tracked.UpdatedAt = now
+				changed[tracked.ClientOrderId] = tracked
+				events = append(events, Event{
+					Type:    EventStuckPendingSubmit,
+					Order:   tracked.clone(),
+					Message: fmt.Sprintf("order not found at Prime %s after submission", now.Sub(tracked.SubmittedAt)),
+				})
+			}
+			continue
+		}
+
+		if tracked.OrderId != r.Id || tracked.Status != r.Status || tracked.FilledQuantity != r.FilledQuantity {
+			statusChanged := tracked.Status != r.Status
+			tracked.OrderId = r.Id
+			tracked.Status = r.Status
+			tracked.FilledQuantity = r.FilledQuantity
+			tracked.UpdatedAt = now
+			changed[tracked.ClientOrderId] = tracked
+			if statusChanged {
+				events = append(events, Event{Type: EventStatusChanged, Order: tracked.clone(), Remote: r})
+			}
+		}
+	}
+
+	byOrderId := make(map[string]*TrackedOrder, len(o.orders))
+	for _, tracked := range o.orders {
+		if len(tracked.OrderId) > 0 {
+			byOrderId[tracked.OrderId] = tracked
+		}
+	}
+
+	for _, f := range fills {
+		tracked, ok := byOrderId[f.OrderId]
+		if !ok || tracked.hasFill(f.Id) {
+			continue
+		}
+		tracked.Fills = append(tracked.Fills, f)
+		tracked.UpdatedAt = now
+		changed[tracked.ClientOrderId] = tracked
+		events = append(events, Event{Type: EventFill, Order: tracked.clone(), Fill: f})
+	}
+
+	for _, tracked := range changed {
+		if missing := missingFillQuantity(tracked); missing.IsPositive() {
+			events = append(events, Event{
+				Type:    EventMissedFill,
+				Order:   tracked.clone(),
+				Message: fmt.Sprintf("filled quantity %s exceeds observed fills by %s", tracked.FilledQuantity, missing),
+			})
+		}
+	}
+
+	toSave := make([]*TrackedOrder, 0, len(changed))
+	for _, tracked := range changed {
+		toSave = append(toSave, tracked.clone())
+	}
+
+	var pruned []string
+	for id, tracked := range o.orders {
+		if o.prunable(tracked, now) {
+			delete(o.orders, id)
+			pruned = append(pruned, id)
+		}
+	}
+	o.mu.Unlock()
+
+	for _, r := range openResp.Orders {
+		if !known[r.Id] {
+			events = append(events, Event{Type: EventUnknownOpenOrder, Remote: r})
+		}
+	}
+
+	var saveErr error
+	for _, tracked := range toSave {
+		if err := o.config.Store.Save(ctx, tracked); err != nil && saveErr == nil {
+			saveErr = fmt.Errorf("unable to persist order %s: %w", tracked.ClientOrderId, err)
+		}
+	}
+
+	for _, id := range pruned {
+		if err := o.config.Store.Delete(ctx, id); err != nil && saveErr == nil {
+			saveErr = fmt.Errorf("unable to delete order %s: %w", id, err)
+		}
+	}
+
+	for _, e := range events {
+		o.emit(e)
+	}
+
+	return saveErr
+}
+
+// prunable returns true for a terminal order unchanged for Retention whose fills were all observed,
+// or which is older than Lookback
+func (o *OMS) prunable(tracked *TrackedOrder, now time.Time) bool {
+	if !tracked.IsTerminal() {
+		return false
+	}
+	idle := now.Sub(tracked.UpdatedAt)
+	if idle < o.config.Retention {
+		return false
+	}
+	return !missingFillQuantity(tracked).IsPositive() || idle >= o.config.Lookback
+}
+
+// needsClosedOrders returns true when a non-terminal tracked order is missing from the open orders
+func (o *OMS) needsClosedOrders(remote *remoteIndex) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, tracked := range o.orders {
+		if !tracked.IsTerminal() && remote.find(tracked) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// missingFillQuantity returns how much of the order's filled quantity is not covered by observed fills
+func missingFillQuantity(tracked *TrackedOrder) decimal.Decimal {
+	// FilledQuantity is copied from the Prime order, so it parses the same way
+	filled, err := model.Order{FilledQuantity: tracked.FilledQuantity}.FilledQuantityNum()
+	if err != nil {
+		return decimal.Zero
+	}
+
+	observed := decimal.Zero
+	for _, f := range tracked.Fills {
+		if qty, err := f.FilledQuantityNum(); err == nil {
+			observed = observed.Add(qty)
+		}
+	}
+	return filled.Sub(observed)
+}
+
+// remoteIndex looks up Prime orders by order id or client order id
+type remoteIndex struct {
+	byOrderId       map[string]*model.Order
+	byClientOrderId map[string]*model.Order
+}
+
+func newRemoteIndex(orders []*model.Order) *remoteIndex {
+	idx := &remoteIndex{
+		byOrderId:       make(map[string]*model.Order),
+		byClientOrderId: make(map[string]*model.Order),
+	}
+	idx.add(orders)
+	return idx
+}
+
+// add indexes orders, keeping entries already present (open orders are added first and are freshest)
+func (idx *remoteIndex) add(orders []*model.Order) {
+	for _, r := range orders {
+		if _, ok := idx.byOrderId[r.Id]; !ok {
+			idx.byOrderId[r.Id] = r
+		}
+		if len(r.ClientOrderId) > 0 {
+			if _, ok := idx.byClientOrderId[r.ClientOrderId]; !ok {
+				idx.byClientOrderId[r.ClientOrderId] = r
+			}
+		}
+	}
+}
+
+func (idx *remoteIndex) find(tracked *TrackedOrder) *model.Order {
+	if len(tracked.OrderId) > 0 {
+		if r, ok := idx.byOrderId[tracked.OrderId]; ok {
+			return r
+		}
+	}
+	return idx.byClientOrderId[tracked.ClientOrderId]
+}
@@ -0,0 +1,135 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/coinbase-samples/prime-sdk-go/internal/jsonfile"
+)
+
+// Store persists tracked orders so the OMS can recover after a restart
+type Store interface {
+	// Load returns every persisted order
+	Load(ctx context.Context) ([]*TrackedOrder, error)
+	// Save inserts or replaces the order keyed by its ClientOrderId
+	Save(ctx context.Context, order *TrackedOrder) error
+	// Delete removes a pruned order. Deleting an order that is not stored is not an error.
+	Delete(ctx context.Context, clientOrderId string) error
+}
+
+// MemoryStore is an in-process Store. State is lost when the process exits.
+type MemoryStore struct {
+	mu     sync.Mutex
+	orders map[string]*TrackedOrder
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{orders: make(map[string]*TrackedOrder)}
+}
+
+func (s *MemoryStore) Load(ctx context.Context) ([]*TrackedOrder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	orders := make([]*TrackedOrder, 0, len(s.orders))
+	for _, o := range s.orders {
+		orders = append(orders, o.clone())
+	}
+	return orders, nil
+}
+
+func (s *MemoryStore) Save(ctx context.Context, order *TrackedOrder) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.orders[order.ClientOrderId] = order.clone()
+	return nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, clientOrderId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.orders, clientOrderId)
+	return nil
+}
+
+// FileStore keeps one JSON file per tracked order in a directory, replaced atomically on save
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore creates a store rooted at dir, creating the directory if needed
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create oms dir %s: %w", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) Load(ctx context.Context) ([]*TrackedOrder, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []*TrackedOrder
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(s.dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		o := &TrackedOrder{}
+		if err := json.Unmarshal(b, o); err != nil {
+			return nil, fmt.Errorf("invalid oms order file %s: %w", e.Name(), err)
+		}
+		orders = append(orders, o)
+	}
+	return orders, nil
+}
+
+func (s *FileStore) Save(ctx context.Context, order *TrackedOrder) error {
+	return jsonfile.Write(s.path(order.ClientOrderId), order)
+}
+
+func (s *FileStore) Delete(ctx context.Context, clientOrderId string) error {
+	err := os.Remove(s.path(clientOrderId))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (s *FileStore) path(clientOrderId string) string {
+	return filepath.Join(s.dir, url.PathEscape(clientOrderId)+".json")
+}
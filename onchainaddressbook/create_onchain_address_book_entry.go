@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/coinbase-samples/core-go"
+	"github.com/coinbase-samples/prime-sdk-go/blockchain"
 	"github.com/coinbase-samples/prime-sdk-go/client"
 	"github.com/coinbase-samples/prime-sdk-go/model"
 )
@@ -30,6 +31,22 @@ type CreateOnchainAddressBookEntryRequest struct {
 	PortfolioId  string                     `json:"portfolio_id"`
 }
 
+// Validate checks each address of the group offline against the group network type
+func (r *CreateOnchainAddressBookEntryRequest) Validate() error {
+	if r.AddressGroup == nil {
+		return nil
+	}
+	for _, a := range r.AddressGroup.Addresses {
+		if a == nil {
+			continue
+		}
+		if err := blockchain.ValidateOnchainAddress(r.AddressGroup.NetworkType, a.Address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type CreateOnchainAddressBookEntryResponse struct {
 	ActivityId         string                                `json:"activity_id"`
 	ActivityType       model.OnchainActivityType             `json:"activity_type"`
@@ -42,6 +59,10 @@ func (s *onchainAddressBookServiceImpl) CreateOnchainAddressBookEntry(
 	request *CreateOnchainAddressBookEntryRequest,
 ) (*CreateOnchainAddressBookEntryResponse, error) {
 
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/portfolios/%s/onchain_address_group", request.PortfolioId)
 
 	response := &CreateOnchainAddressBookEntryResponse{Request: request}
@@ -0,0 +1,298 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/client"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+// CancelAllOrdersRequest selects the open orders to cancel. Empty filters match every open order.
+type CancelAllOrdersRequest struct {
+	PortfolioId string // required
+	ProductIds  []string
+	OrderSide   string
+	OrderType   model.OrderType
+	// OlderThan only cancels orders created at least this long ago (0 = any age)
+	OlderThan time.Duration
+	// Concurrency is the number of cancels in flight (default 5)
+	Concurrency int
+	// RequestsPerSecond paces cancel calls across all workers (default 10)
+	RequestsPerSecond float64
+	// MaxRetries is the number of retries for a rate limited cancel (default 3)
+	MaxRetries int
+	// MaxRounds is the number of times open orders are listed and cancelled (default 5). ListOpenOrders
+	// returns at most 1000 orders, so larger books and orders created during the run need more rounds.
+	MaxRounds int
+	// VerifyTimeout is how long to wait for cancelled orders to leave the open orders (default 10s)
+	VerifyTimeout time.Duration
+	// SkipVerify disables the final open orders check
+	SkipVerify bool
+}
+
+// CancelOrderResult is the outcome of cancelling one order
+type CancelOrderResult struct {
+	Order     *model.Order
+	Cancelled bool
+	Attempts  int
+	Err       error
+}
+
+// CancelAllOrdersReport summarizes a CancelAllOrders run
+type CancelAllOrdersReport struct {
+	Results   []*CancelOrderResult
+	Cancelled int
+	Failed    int
+	// Rounds is the number of times open orders were listed and cancelled
+	Rounds int
+	// RemainingOpen lists matching orders still open after verification
+	RemainingOpen []*model.Order
+	// Skipped lists open orders that were not cancelled because their age is unknown, i.e. they have
+	// no created time and OlderThan is set
+	Skipped []*model.Order
+}
+
+// CancelAllOrders cancels every open order matching the request with bounded parallelism.
+// Rate limited cancels are retried with backoff. Open orders are listed and cancelled in rounds until
+// a listing has no order left to cancel or MaxRounds is reached, since one listing returns at most
+// 1000 orders. Unless SkipVerify is set, open orders are then listed again until no matching order
+// is open or VerifyTimeout passes. Per-order failures are reported in the result; an error is
+// returned if listing fails, any cancel fails, or matching orders remain open.
+// Orders without a created time are reported as Skipped when OlderThan is set.
+func CancelAllOrders(ctx context.Context, service OrdersService, request *CancelAllOrdersRequest) (*CancelAllOrdersReport, error) {
+	if request == nil || len(request.PortfolioId) == 0 {
+		return nil, errors.New("portfolio id is required")
+	}
+
+	cfg := *request
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 5
+	}
+	if cfg.RequestsPerSecond <= 0 {
+		cfg.RequestsPerSecond = 10
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = 3
+	}
+	if cfg.MaxRounds <= 0 {
+		cfg.MaxRounds = 5
+	}
+	if cfg.VerifyTimeout <= 0 {
+		cfg.VerifyTimeout = 10 * time.Second
+	}
+
+	// The cutoff is fixed so orders do not become targets by ageing during the run
+	var cutoff time.Time
+	if cfg.OlderThan > 0 {
+		cutoff = time.Now().Add(-cfg.OlderThan)
+	}
+
+	pace := time.NewTicker(time.Duration(float64(time.Second) / cfg.RequestsPerSecond))
+	defer pace.Stop()
+
+	report := &CancelAllOrdersReport{}
+	attempted := make(map[string]bool)
+	skipped := make(map[string]bool)
+
+	for report.Rounds < cfg.MaxRounds {
+		open, err := listOpenOrders(ctx, service, &cfg)
+		if err != nil {
+			return report, fmt.Errorf("unable to list open orders: %w", err)
+		}
+
+		// Orders already attempted are either pending cancellation or failed, and are not retried
+		var targets []*model.Order
+		matching, unknownAge := selectCancelTargets(open, cutoff)
+		for _, o := range matching {
+			if !attempted[o.Id] {
+				attempted[o.Id] = true
+				targets = append(targets, o)
+			}
+		}
+		for _, o := range unknownAge {
+			if !skipped[o.Id] {
+				skipped[o.Id] = true
+				report.Skipped = append(report.Skipped, o)
+			}
+		}
+		if len(targets) == 0 {
+			break
+		}
+
+		report.Rounds++
+		report.Results = append(report.Results, cancelBatch(ctx, service, &cfg, targets, pace.C)...)
+	}
+
+	for _, r := range report.Results {
+		if r.Cancelled {
+			report.Cancelled++
+		} else {
+			report.Failed++
+		}
+	}
+
+	if !cfg.SkipVerify && len(report.Results) > 0 {
+		var err error
+		if report.RemainingOpen, err = verifyCancelled(ctx, service, &cfg, cutoff); err != nil {
+			return report, err
+		}
+	}
+
+	switch {
+	case report.Failed > 0:
+		return report, fmt.Errorf("%d of %d cancels failed", report.Failed, len(report.Results))
+	case len(report.RemainingOpen) > 0:
+		return report, fmt.Errorf("%d orders remain open after cancel", len(report.RemainingOpen))
+	}
+
+	return report, nil
+}
+
+// cancelBatch cancels targets on Concurrency workers and returns their results in order
+func cancelBatch(
+	ctx context.Context,
+	service OrdersService,
+	cfg *CancelAllOrdersRequest,
+	targets []*model.Order,
+	pace <-chan time.Time,
+) []*CancelOrderResult {
+	results := make([]*CancelOrderResult, len(targets))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < cfg.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = cancelWithRetry(ctx, service, cfg, targets[i], pace)
+			}
+		}()
+	}
+	for i := range targets {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func listOpenOrders(ctx context.Context, service OrdersService, cfg *CancelAllOrdersRequest) ([]*model.Order, error) {
+	resp, err := service.ListOpenOrders(ctx, &ListOpenOrdersRequest{
+		PortfolioId: cfg.PortfolioId,
+		ProductIds:  cfg.ProductIds,
+		OrderSide:   cfg.OrderSide,
+		OrderType:   cfg.OrderType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Orders, nil
+}
+
+// selectCancelTargets returns the open orders created at or before cutoff, or all of them for a zero
+// cutoff, and separately those whose age is unknown
+func selectCancelTargets(open []*model.Order, cutoff time.Time) ([]*model.Order, []*model.Order) {
+	if cutoff.IsZero() {
+		return open, nil
+	}
+
+	var targets, unknownAge []*model.Order
+	for _, o := range open {
+		switch {
+		case o.Created.IsZero():
+			unknownAge = append(unknownAge, o)
+		case !o.Created.After(cutoff):
+			targets = append(targets, o)
+		}
+	}
+	return targets, unknownAge
+}
+
+func cancelWithRetry(
+	ctx context.Context,
+	service OrdersService,
+	cfg *CancelAllOrdersRequest,
+	order *model.Order,
+	pace <-chan time.Time,
+) *CancelOrderResult {
+	result := &CancelOrderResult{Order: order}
+	backoff := 500 * time.Millisecond
+
+	for {
+		select {
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			return result
+		case <-pace:
+		}
+
+		result.Attempts++
+		_, err := service.CancelOrder(ctx, &CancelOrderRequest{PortfolioId: cfg.PortfolioId, OrderId: order.Id})
+		if err == nil {
+			result.Cancelled = true
+			result.Err = nil
+			return result
+		}
+		result.Err = err
+
+		if !client.IsRateLimited(err) || result.Attempts > cfg.MaxRetries {
+			return result
+		}
+
+		select {
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			return result
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// verifyCancelled polls open orders until none match the request or the timeout passes, returning
+// the matching orders still open
+func verifyCancelled(ctx context.Context, service OrdersService, cfg *CancelAllOrdersRequest, cutoff time.Time) ([]*model.Order, error) {
+	deadline := time.Now().Add(cfg.VerifyTimeout)
+	interval := 250 * time.Millisecond
+
+	for {
+		open, err := listOpenOrders(ctx, service, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify open orders: %w", err)
+		}
+		remaining, _ := selectCancelTargets(open, cutoff)
+
+		if len(remaining) == 0 || time.Now().Add(interval).After(deadline) {
+			return remaining, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return remaining, ctx.Err()
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
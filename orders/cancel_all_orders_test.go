@@ -0,0 +1,181 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/core-go"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+// openOrderBook serves open orders and removes them when cancelled
+type openOrderBook struct {
+	mu       sync.Mutex
+	open     []*model.Order
+	attempts map[string]int
+	// failures maps an order id to the errors returned by its first cancel attempts
+	failures map[string][]error
+	// sticky orders accept the cancel but stay open
+	sticky map[string]bool
+	// limit caps the number of open orders listed (0 = no cap)
+	limit int
+}
+
+func (b *openOrderBook) service() *stubOrdersService {
+	return &stubOrdersService{
+		listOpenOrders: func(ctx context.Context, r *ListOpenOrdersRequest) (*ListOpenOrdersResponse, error) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			open := b.open
+			if b.limit > 0 && len(open) > b.limit {
+				open = open[:b.limit]
+			}
+			return &ListOpenOrdersResponse{Orders: append([]*model.Order(nil), open...)}, nil
+		},
+		cancelOrder: func(ctx context.Context, r *CancelOrderRequest) (*CancelOrderResponse, error) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			n := b.attempts[r.OrderId]
+			b.attempts[r.OrderId]++
+			if errs := b.failures[r.OrderId]; n < len(errs) {
+				return nil, errs[n]
+			}
+
+			if !b.sticky[r.OrderId] {
+				for i, o := range b.open {
+					if o.Id == r.OrderId {
+						b.open = append(b.open[:i], b.open[i+1:]...)
+						break
+					}
+				}
+			}
+			return &CancelOrderResponse{OrderId: r.OrderId, Request: r}, nil
+		},
+	}
+}
+
+func newOpenOrderBook(orders ...*model.Order) *openOrderBook {
+	return &openOrderBook{
+		open:     orders,
+		attempts: make(map[string]int),
+		failures: make(map[string][]error),
+		sticky:   make(map[string]bool),
+	}
+}
+
+func fastCancelRequest() *CancelAllOrdersRequest {
+	return &CancelAllOrdersRequest{PortfolioId: "p1", RequestsPerSecond: 1000, VerifyTimeout: time.Second}
+}
+
+func TestCancelAllOrdersRetriesRateLimited(t *testing.T) {
+	book := newOpenOrderBook(&model.Order{Id: "o1"}, &model.Order{Id: "o2"}, &model.Order{Id: "o3"})
+	book.failures["o2"] = []error{&core.ApiError{CodeReceived: http.StatusTooManyRequests}}
+
+	report, err := CancelAllOrders(context.Background(), book.service(), fastCancelRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Cancelled != 3 || report.Failed != 0 || len(report.RemainingOpen) != 0 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+	if book.attempts["o2"] != 2 {
+		t.Errorf("expected rate limited cancel to be retried, got %d attempts", book.attempts["o2"])
+	}
+}
+
+func TestCancelAllOrdersRepeatsCappedListings(t *testing.T) {
+	book := newOpenOrderBook(&model.Order{Id: "o1"}, &model.Order{Id: "o2"}, &model.Order{Id: "o3"})
+	book.limit = 2
+
+	report, err := CancelAllOrders(context.Background(), book.service(), fastCancelRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Cancelled != 3 || report.Rounds != 2 || len(book.open) != 0 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+
+	book = newOpenOrderBook(&model.Order{Id: "o1"}, &model.Order{Id: "o2"}, &model.Order{Id: "o3"})
+	book.limit = 1
+	request := fastCancelRequest()
+	request.MaxRounds = 2
+	request.VerifyTimeout = 300 * time.Millisecond
+
+	report, err = CancelAllOrders(context.Background(), book.service(), request)
+	if err == nil || len(report.RemainingOpen) != 1 {
+		t.Fatalf("expected an order to remain open after the last round, got %+v, %v", report, err)
+	}
+}
+
+func TestCancelAllOrdersReportsFailures(t *testing.T) {
+	book := newOpenOrderBook(&model.Order{Id: "o1"}, &model.Order{Id: "o2"}, &model.Order{Id: "o3"})
+	book.failures["o1"] = []error{errors.New("boom")}
+	book.sticky["o3"] = true
+
+	request := fastCancelRequest()
+	request.VerifyTimeout = 300 * time.Millisecond
+
+	report, err := CancelAllOrders(context.Background(), book.service(), request)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if report.Cancelled != 2 || report.Failed != 1 || book.attempts["o1"] != 1 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+	if len(report.RemainingOpen) != 2 {
+		t.Errorf("expected o1 and o3 to remain open, got %+v", report.RemainingOpen)
+	}
+}
+
+func TestCancelAllOrdersOlderThan(t *testing.T) {
+	now := time.Now().UTC()
+	book := newOpenOrderBook(
+		&model.Order{Id: "old", Created: model.NewTimestamp(now.Add(-2 * time.Hour))},
+		&model.Order{Id: "new", Created: model.NewTimestamp(now.Add(-time.Minute))},
+		&model.Order{Id: "unknown"},
+	)
+
+	request := fastCancelRequest()
+	request.OlderThan = time.Hour
+
+	report, err := CancelAllOrders(context.Background(), book.service(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Results) != 1 || report.Results[0].Order.Id != "old" {
+		t.Errorf("expected only the old order to be cancelled, got %+v", report.Results)
+	}
+	if len(report.Skipped) != 1 || report.Skipped[0].Id != "unknown" {
+		t.Errorf("expected the order without a created time to be skipped, got %+v", report.Skipped)
+	}
+	if len(book.open) != 2 {
+		t.Errorf("unexpected open orders: %+v", book.open)
+	}
+}
+
+func TestCancelAllOrdersRequiresPortfolio(t *testing.T) {
+	if _, err := CancelAllOrders(context.Background(), &stubOrdersService{}, &CancelAllOrdersRequest{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -30,7 +30,7 @@ import (
 type ListOpenOrdersRequest struct {
 	PortfolioId string                  `json:"portfolio_id"`
 	ProductIds  []string                `json:"product_ids,omitempty"`
-	OrderType   string                  `json:"order_type,omitempty"`
+	OrderType   model.OrderType         `json:"order_type,omitempty"`
 	OrderSide   string                  `json:"order_side,omitempty"`
 	Start       time.Time               `json:"start_date,omitempty"`
 	End         time.Time               `json:"end_date,omitempty"`
@@ -66,7 +66,7 @@ func (s *ordersServiceImpl) ListOpenOrders(
 	}
 
 	if request.OrderType != "" {
-		queryParams = core.AppendHttpQueryParam(queryParams, "order_type", request.OrderType)
+		queryParams = core.AppendHttpQueryParam(queryParams, "order_type", string(request.OrderType))
 	}
 
 	if request.OrderSide != "" {
@@ -29,9 +29,9 @@ import (
 
 type ListOrdersRequest struct {
 	PortfolioId string                  `json:"portfolio_id"` // required
-	Statuses    []string                `json:"order_statuses"`
+	Statuses    []model.OrderStatus     `json:"order_statuses"`
 	ProductIds  []string                `json:"product_ids"`
-	Type        string                  `json:"order_type"`
+	Type        model.OrderType         `json:"order_type"`
 	OrderSide   string                  `json:"order_side"`
 	Start       time.Time               `json:"start_date"` // required
 	End         time.Time               `json:"end_date"`
@@ -65,6 +65,29 @@ func (r *ListOrdersResponse) Iterator() *model.PageIterator[*ListOrdersResponse,
 	}, r.serviceConfig)
 }
 
+// BackfillOrders lists orders between request.Start and request.End by paging time windows
+// concurrently. Start is required and a zero End means now. Results are deduped by id and sorted by time.
+func BackfillOrders(
+	ctx context.Context,
+	service OrdersService,
+	request *ListOrdersRequest,
+	config *model.BackfillConfig,
+) ([]*model.Order, error) {
+	return model.BackfillList(ctx, model.ListBackfill[ListOrdersRequest, *ListOrdersResponse, *model.Order]{
+		Start: request.Start,
+		End:   request.End,
+		Window: func(start, end time.Time) *ListOrdersRequest {
+			window := *request
+			window.Start, window.End, window.Pagination = start, end, model.FirstPage(request.Pagination)
+			return &window
+		},
+		List:  service.ListOrders,
+		Items: func(resp *ListOrdersResponse) []*model.Order { return resp.Orders },
+		Id:    func(o *model.Order) string { return o.Id },
+		Time:  func(o *model.Order) time.Time { return o.Created.Time },
+	}, config)
+}
+
 // ListOrders returns orders based on query params. Start time is required.
 // This API endpoint cannot list open orders, so do not add an OPEN status
 // to the status param.
@@ -89,7 +112,7 @@ func (s *ordersServiceImpl) ListOrders(
 	}
 
 	if len(request.Type) > 0 {
-		queryParams = core.AppendHttpQueryParam(queryParams, "order_type", request.Type)
+		queryParams = core.AppendHttpQueryParam(queryParams, "order_type", string(request.Type))
 	}
 
 	if len(request.OrderSide) > 0 {
@@ -97,7 +120,7 @@ func (s *ordersServiceImpl) ListOrders(
 	}
 
 	for _, st := range request.Statuses {
-		queryParams = core.AppendHttpQueryParam(queryParams, "order_statuses", st)
+		queryParams = core.AppendHttpQueryParam(queryParams, "order_statuses", string(st))
 	}
 
 	for _, p := range request.ProductIds {
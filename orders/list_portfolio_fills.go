@@ -61,6 +61,53 @@ func (r *ListPortfolioFillsResponse) Iterator() *model.PageIterator[*ListPortfol
 	}, r.serviceConfig)
 }
 
+// NewResumableListPortfolioFills returns a ResumableIterator that saves its progress in store under key.
+// If a checkpoint already exists for key, iteration resumes from it instead of request.
+func NewResumableListPortfolioFills(
+	service OrdersService,
+	request *ListPortfolioFillsRequest,
+	key string,
+	store model.CheckpointStore,
+) *model.ResumableIterator[ListPortfolioFillsRequest, *ListPortfolioFillsResponse, *model.OrderFill] {
+	return model.NewResumableIterator(
+		key,
+		store,
+		request,
+		service.ListPortfolioFills,
+		func(resp *ListPortfolioFillsResponse) []*model.OrderFill {
+			return resp.Fills
+		},
+		func(req *ListPortfolioFillsRequest, cursor string) *ListPortfolioFillsRequest {
+			next := *req
+			next.Pagination = model.PrepareNextPagination(req.Pagination, cursor)
+			return &next
+		},
+	).WithConfig(service.ServiceConfig())
+}
+
+// BackfillPortfolioFills lists fills between request.Start and request.End by paging time windows
+// concurrently. Start is required and a zero End means now. Results are deduped by id and sorted by time.
+func BackfillPortfolioFills(
+	ctx context.Context,
+	service OrdersService,
+	request *ListPortfolioFillsRequest,
+	config *model.BackfillConfig,
+) ([]*model.OrderFill, error) {
+	return model.BackfillList(ctx, model.ListBackfill[ListPortfolioFillsRequest, *ListPortfolioFillsResponse, *model.OrderFill]{
+		Start: request.Start,
+		End:   request.End,
+		Window: func(start, end time.Time) *ListPortfolioFillsRequest {
+			window := *request
+			window.Start, window.End, window.Pagination = start, end, model.FirstPage(request.Pagination)
+			return &window
+		},
+		List:  service.ListPortfolioFills,
+		Items: func(resp *ListPortfolioFillsResponse) []*model.OrderFill { return resp.Fills },
+		Id:    func(f *model.OrderFill) string { return f.Id },
+		Time:  func(f *model.OrderFill) time.Time { return f.Time.Time },
+	}, config)
+}
+
 func (s *ordersServiceImpl) ListPortfolioFills(
 	ctx context.Context,
 	request *ListPortfolioFillsRequest,
@@ -0,0 +1,457 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/utils"
+	"github.com/shopspring/decimal"
+)
+
+// OrderViolation describes one reason an order failed validation
+type OrderViolation struct {
+	Field   string
+	Message string
+}
+
+func (v OrderViolation) Error() string {
+	return fmt.Sprintf("%s: %s", v.Field, v.Message)
+}
+
+// OrderValidationError lists every violation found while building an order
+type OrderValidationError struct {
+	Violations []OrderViolation
+}
+
+func (e *OrderValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Error()
+	}
+	return fmt.Sprintf("invalid order: %s", strings.Join(msgs, "; "))
+}
+
+// OrderBuilder assembles a model.Order for a product, rounding sizes and prices to the
+// product increments and validating the combination of fields before CreateOrder is called.
+//
+//	order, err := orders.NewOrder(product).
+//		Portfolio(portfolioId).
+//		Buy().
+//		Limit(price).
+//		Size(qty).
+//		GTD(expiry).
+//		PostOnly().
+//		Build()
+type OrderBuilder struct {
+	product       *model.Product
+	portfolioId   string
+	clientOrderId string
+	side          model.OrderSide
+	orderType     model.OrderType
+	timeInForce   model.TimeInForce
+	baseQuantity  *decimal.Decimal
+	quoteValue    *decimal.Decimal
+	limitPrice    *decimal.Decimal
+	stopPrice     *decimal.Decimal
+	displayBase   *decimal.Decimal
+	displayQuote  *decimal.Decimal
+	startTime     time.Time
+	expiryTime    time.Time
+	postOnly      bool
+	stpId         string
+	now           func() time.Time
+}
+
+// NewOrder starts building an order for product
+func NewOrder(product *model.Product) *OrderBuilder {
+	return &OrderBuilder{product: product, now: time.Now}
+}
+
+// Portfolio sets the portfolio that places the order
+func (b *OrderBuilder) Portfolio(portfolioId string) *OrderBuilder {
+	b.portfolioId = portfolioId
+	return b
+}
+
+// ClientOrderId sets the client order id. A UUID is generated by Build when not set.
+func (b *OrderBuilder) ClientOrderId(clientOrderId string) *OrderBuilder {
+	b.clientOrderId = clientOrderId
+	return b
+}
+
+func (b *OrderBuilder) Buy() *OrderBuilder {
+	b.side = model.OrderSideBuy
+	return b
+}
+
+func (b *OrderBuilder) Sell() *OrderBuilder {
+	b.side = model.OrderSideSell
+	return b
+}
+
+func (b *OrderBuilder) Market() *OrderBuilder {
+	b.orderType = model.OrderTypeMarket
+	return b
+}
+
+func (b *OrderBuilder) Limit(price decimal.Decimal) *OrderBuilder {
+	b.orderType = model.OrderTypeLimit
+	b.limitPrice = &price
+	return b
+}
+
+// StopLimit creates a limit order at limit that activates once the market crosses stop
+func (b *OrderBuilder) StopLimit(stop, limit decimal.Decimal) *OrderBuilder {
+	b.orderType = model.OrderTypeStopLimit
+	b.stopPrice = &stop
+	b.limitPrice = &limit
+	return b
+}
+
+// Twap executes between start and end with a worst acceptable price of limit
+func (b *OrderBuilder) Twap(limit decimal.Decimal, start, end time.Time) *OrderBuilder {
+	b.orderType = model.OrderTypeTwap
+	b.limitPrice = &limit
+	b.startTime = start
+	b.expiryTime = end
+	return b
+}
+
+// Vwap executes with a worst acceptable price of limit
+func (b *OrderBuilder) Vwap(limit decimal.Decimal) *OrderBuilder {
+	b.orderType = model.OrderTypeVwap
+	b.limitPrice = &limit
+	return b
+}
+
+// Size sets the order size in base asset units
+func (b *OrderBuilder) Size(quantity decimal.Decimal) *OrderBuilder {
+	b.baseQuantity = &quantity
+	return b
+}
+
+// QuoteSize sets the order size in quote asset units
+func (b *OrderBuilder) QuoteSize(value decimal.Decimal) *OrderBuilder {
+	b.quoteValue = &value
+	return b
+}
+
+func (b *OrderBuilder) GTC() *OrderBuilder {
+	b.timeInForce = model.TimeInForceGoodUntilCancelled
+	return b
+}
+
+// GTD keeps the order working until expiry
+func (b *OrderBuilder) GTD(expiry time.Time) *OrderBuilder {
+	b.timeInForce = model.TimeInForceGoodUntilTime
+	b.expiryTime = expiry
+	return b
+}
+
+func (b *OrderBuilder) IOC() *OrderBuilder {
+	b.timeInForce = model.TimeInForceImmediateOrCancel
+	return b
+}
+
+func (b *OrderBuilder) FOK() *OrderBuilder {
+	b.timeInForce = model.TimeInForceFillOrKill
+	return b
+}
+
+// PostOnly only posts the order to the book, it is never matched on entry
+func (b *OrderBuilder) PostOnly() *OrderBuilder {
+	b.postOnly = true
+	return b
+}
+
+// DisplaySize makes a limit order an iceberg that shows at most size base units
+func (b *OrderBuilder) DisplaySize(size decimal.Decimal) *OrderBuilder {
+	b.displayBase = &size
+	return b
+}
+
+// DisplayQuoteSize makes a limit order an iceberg that shows at most size quote units
+func (b *OrderBuilder) DisplayQuoteSize(size decimal.Decimal) *OrderBuilder {
+	b.displayQuote = &size
+	return b
+}
+
+func (b *OrderBuilder) StpId(stpId string) *OrderBuilder {
+	b.stpId = stpId
+	return b
+}
+
+// Build validates the order and returns it. Sizes are rounded down to the product increments,
+// prices are rounded to the passive side (down for buys, up for sells). If anything is invalid,
+// Build returns an *OrderValidationError listing every violation.
+func (b *OrderBuilder) Build() (*model.Order, error) {
+	v := &orderValidator{}
+
+	product := b.product
+	if product == nil {
+		v.add("product_id", "product is required")
+		product = &model.Product{}
+	}
+	if len(b.portfolioId) == 0 {
+		v.add("portfolio_id", "portfolio is required")
+	}
+	if b.side != model.OrderSideBuy && b.side != model.OrderSideSell {
+		v.add("side", "side must be BUY or SELL")
+	}
+	if len(b.orderType) == 0 {
+		v.add("type", "order type is required")
+	}
+
+	order := &model.Order{
+		PortfolioId:   b.portfolioId,
+		Side:          string(b.side),
+		ClientOrderId: b.clientOrderId,
+		ProductId:     product.Id,
+		Type:          b.orderType,
+		TimeInForce:   b.timeInForce,
+		PostOnly:      b.postOnly,
+		StpId:         b.stpId,
+	}
+	if len(order.ClientOrderId) == 0 {
+		order.ClientOrderId = utils.NewUuid()
+	}
+
+	b.validateSize(v, product, order)
+	b.validatePrices(v, product, order)
+	b.validateTimes(v, order)
+	b.validateFlags(v, order)
+
+	if len(v.violations) > 0 {
+		return nil, &OrderValidationError{Violations: v.violations}
+	}
+
+	return order, nil
+}
+
+func (b *OrderBuilder) validateSize(v *orderValidator, product *model.Product, order *model.Order) {
+	baseInc := v.num("base_increment", product.BaseIncrement)
+	quoteInc := v.num("quote_increment", product.QuoteIncrement)
+
+	switch {
+	case b.baseQuantity == nil && b.quoteValue == nil:
+		v.add("base_quantity", "either base quantity or quote value is required")
+		return
+	case b.baseQuantity != nil && b.quoteValue != nil:
+		v.add("base_quantity", "only one of base quantity or quote value may be set")
+		return
+	}
+
+	// Display sizes are compared with the rounded order size that is actually sent
+	var qty, value decimal.Decimal
+
+	if b.baseQuantity != nil {
+		qty = v.round(*b.baseQuantity, baseInc, utils.RoundDown, b.side)
+		if v.positive("base_quantity", qty) {
+			v.within("base_quantity", qty, v.num("base_min_size", product.BaseMinSize), v.num("base_max_size", product.BaseMaxSize))
+		}
+		order.BaseQuantity = qty.String()
+	}
+
+	if b.quoteValue != nil {
+		value = v.round(*b.quoteValue, quoteInc, utils.RoundDown, b.side)
+		if v.positive("quote_value", value) {
+			v.within("quote_value", value, v.num("quote_min_size", product.QuoteMinSize), v.num("quote_max_size", product.QuoteMaxSize))
+		}
+		order.QuoteValue = value.String()
+	}
+
+	if b.displayBase != nil && b.displayQuote != nil {
+		v.add("display_base_size", "only one of display base size or display quote size may be set")
+	}
+
+	if b.displayBase != nil {
+		size := v.round(*b.displayBase, baseInc, utils.RoundDown, b.side)
+		if v.positive("display_base_size", size) && b.baseQuantity != nil && size.GreaterThan(qty) {
+			v.add("display_base_size", "display size cannot exceed the order size")
+		}
+		order.DisplayBaseSize = size.String()
+	}
+
+	if b.displayQuote != nil {
+		size := v.round(*b.displayQuote, quoteInc, utils.RoundDown, b.side)
+		if v.positive("display_quote_size", size) && b.quoteValue != nil && size.GreaterThan(value) {
+			v.add("display_quote_size", "display size cannot exceed the order size")
+		}
+		order.DisplayQuoteSize = size.String()
+	}
+}
+
+func (b *OrderBuilder) validatePrices(v *orderValidator, product *model.Product, order *model.Order) {
+	switch b.orderType {
+	case model.OrderTypeLimit, model.OrderTypeStopLimit, model.OrderTypeTwap, model.OrderTypeVwap:
+		if b.limitPrice == nil {
+			v.add("limit_price", fmt.Sprintf("limit price is required for %s orders", b.orderType))
+		}
+	case model.OrderTypeMarket:
+		if b.limitPrice != nil {
+			v.add("limit_price", "limit price is not allowed for MARKET orders")
+		}
+	}
+
+	if b.stopPrice == nil && b.orderType == model.OrderTypeStopLimit {
+		v.add("stop_price", "stop price is required for STOP_LIMIT orders")
+	}
+
+	// Round towards the passive side so rounding never makes the order more aggressive
+	inc := v.num("price_increment", priceIncrement(product))
+
+	var limit decimal.Decimal
+	if b.limitPrice != nil {
+		limit = v.round(*b.limitPrice, inc, utils.RoundPassive, b.side)
+		v.positive("limit_price", limit)
+		order.LimitPrice = limit.String()
+	}
+
+	if b.stopPrice != nil {
+		stop := v.round(*b.stopPrice, inc, utils.RoundPassive, b.side)
+		v.positive("stop_price", stop)
+		order.StopPrice = stop.String()
+
+		// Both prices are compared as rounded, since rounding can reorder prices within one increment
+		if b.limitPrice != nil {
+			if b.side == model.OrderSideBuy && limit.LessThan(stop) {
+				v.add("limit_price", "limit price of a buy stop limit cannot be below the stop price")
+			}
+			if b.side == model.OrderSideSell && limit.GreaterThan(stop) {
+				v.add("limit_price", "limit price of a sell stop limit cannot be above the stop price")
+			}
+		}
+	}
+}
+
+func (b *OrderBuilder) validateTimes(v *orderValidator, order *model.Order) {
+	now := b.now()
+
+	if !b.startTime.IsZero() {
+		if b.orderType != model.OrderTypeTwap {
+			v.add("start_time", "start time is only allowed for TWAP orders")
+		}
+		order.StartTime = model.NewTimestamp(b.startTime)
+	}
+
+	switch {
+	case b.orderType == model.OrderTypeTwap && b.expiryTime.IsZero():
+		v.add("expiry_time", "expiry time is required for TWAP orders")
+	case b.timeInForce == model.TimeInForceGoodUntilTime && b.expiryTime.IsZero():
+		v.add("expiry_time", "expiry time is required for GOOD_UNTIL_DATE_TIME orders")
+	case !b.expiryTime.IsZero() && b.orderType != model.OrderTypeTwap && b.timeInForce != model.TimeInForceGoodUntilTime:
+		v.add("expiry_time", "expiry time requires GOOD_UNTIL_DATE_TIME time in force")
+	}
+
+	if !b.expiryTime.IsZero() {
+		if !b.expiryTime.After(now) {
+			v.add("expiry_time", "expiry time must be in the future")
+		}
+		if !b.startTime.IsZero() && !b.expiryTime.After(b.startTime) {
+			v.add("expiry_time", "expiry time must be after start time")
+		}
+		order.ExpiryTime = model.NewTimestamp(b.expiryTime)
+	}
+
+	if b.timeInForce == model.TimeInForceGoodUntilTime {
+		switch b.orderType {
+		case model.OrderTypeLimit, model.OrderTypeStopLimit, model.OrderTypeTwap, model.OrderTypeVwap:
+		default:
+			v.add("time_in_force", fmt.Sprintf("GOOD_UNTIL_DATE_TIME is not supported for %s orders", b.orderType))
+		}
+	}
+}
+
+func (b *OrderBuilder) validateFlags(v *orderValidator, order *model.Order) {
+	if b.postOnly {
+		if b.orderType != model.OrderTypeLimit {
+			v.add("post_only", "post only is only allowed for LIMIT orders")
+		}
+		switch b.timeInForce {
+		case "", model.TimeInForceGoodUntilCancelled, model.TimeInForceGoodUntilTime:
+		default:
+			v.add("post_only", fmt.Sprintf("post only is not allowed with %s", b.timeInForce))
+		}
+	}
+
+	if (b.displayBase != nil || b.displayQuote != nil) && b.orderType != model.OrderTypeLimit {
+		v.add("display_base_size", "display size is only allowed for LIMIT orders")
+	}
+
+	if b.orderType == model.OrderTypeMarket && b.timeInForce == model.TimeInForceGoodUntilCancelled {
+		v.add("time_in_force", "GOOD_UNTIL_CANCELLED is not supported for MARKET orders")
+	}
+}
+
+// priceIncrement falls back to the quote increment for products without a price increment
+func priceIncrement(product *model.Product) string {
+	if len(product.PriceIncrement) == 0 {
+		return product.QuoteIncrement
+	}
+	return product.PriceIncrement
+}
+
+type orderValidator struct {
+	violations []OrderViolation
+}
+
+func (v *orderValidator) add(field, message string) {
+	v.violations = append(v.violations, OrderViolation{Field: field, Message: message})
+}
+
+// round rounds value to a multiple of the increment. Values are left untouched when the
+// product does not define the increment.
+func (v *orderValidator) round(value decimal.Decimal, inc *decimal.Decimal, mode utils.RoundingMode, side model.OrderSide) decimal.Decimal {
+	if inc == nil {
+		return value
+	}
+	return utils.RoundToIncrement(value, *inc, mode, side)
+}
+
+func (v *orderValidator) positive(field string, value decimal.Decimal) bool {
+	if !value.IsPositive() {
+		v.add(field, "must be greater than zero after rounding to the product increment")
+		return false
+	}
+	return true
+}
+
+// num parses a product limit, returning nil when the product does not define it
+func (v *orderValidator) num(field, value string) *decimal.Decimal {
+	if len(value) == 0 {
+		return nil
+	}
+	n, err := decimal.NewFromString(value)
+	if err != nil {
+		v.add(field, fmt.Sprintf("invalid product value %q", value))
+		return nil
+	}
+	return &n
+}
+
+func (v *orderValidator) within(field string, value decimal.Decimal, min, max *decimal.Decimal) {
+	if min != nil && value.LessThan(*min) {
+		v.add(field, fmt.Sprintf("%s is below the product minimum of %s", value, min))
+	}
+	if max != nil && max.IsPositive() && value.GreaterThan(*max) {
+		v.add(field, fmt.Sprintf("%s is above the product maximum of %s", value, max))
+	}
+}
@@ -0,0 +1,223 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testProduct() *model.Product {
+	return &model.Product{
+		Id:             "BTC-USD",
+		BaseIncrement:  "0.0001",
+		QuoteIncrement: "0.01",
+		PriceIncrement: "0.5",
+		BaseMinSize:    "0.001",
+		BaseMaxSize:    "100",
+		QuoteMinSize:   "1",
+		QuoteMaxSize:   "1000000",
+	}
+}
+
+func newTestOrder(product *model.Product) *OrderBuilder {
+	b := NewOrder(product).Portfolio("portfolio-1")
+	b.now = func() time.Time { return testNow }
+	return b
+}
+
+func violationFields(t *testing.T, err error) map[string]bool {
+	t.Helper()
+	var verr *OrderValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *OrderValidationError, got %v", err)
+	}
+	fields := make(map[string]bool)
+	for _, v := range verr.Violations {
+		fields[v.Field] = true
+	}
+	return fields
+}
+
+func TestOrderBuilderRoundsToIncrements(t *testing.T) {
+	cases := []struct {
+		description string
+		builder     *OrderBuilder
+		quantity    string
+		limitPrice  string
+	}{
+		{
+			description: "BuyRoundsPriceDown",
+			builder:     newTestOrder(testProduct()).Buy().Limit(decimal.RequireFromString("65000.7")).Size(decimal.RequireFromString("0.12345")),
+			quantity:    "0.1234",
+			limitPrice:  "65000.5",
+		},
+		{
+			description: "SellRoundsPriceUp",
+			builder:     newTestOrder(testProduct()).Sell().Limit(decimal.RequireFromString("65000.2")).Size(decimal.RequireFromString("1")),
+			quantity:    "1",
+			limitPrice:  "65000.5",
+		},
+		{
+			description: "ExactIncrementUnchanged",
+			builder:     newTestOrder(testProduct()).Sell().Limit(decimal.RequireFromString("65000")).Size(decimal.RequireFromString("0.5")),
+			quantity:    "0.5",
+			limitPrice:  "65000",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			order, err := tt.builder.GTC().Build()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if order.BaseQuantity != tt.quantity || order.LimitPrice != tt.limitPrice {
+				t.Errorf("expected %s @ %s, got %s @ %s", tt.quantity, tt.limitPrice, order.BaseQuantity, order.LimitPrice)
+			}
+			if order.ClientOrderId == "" || order.ProductId != "BTC-USD" || order.Type != model.OrderTypeLimit {
+				t.Errorf("unexpected order: %+v", order)
+			}
+		})
+	}
+}
+
+func TestOrderBuilderGtdPostOnly(t *testing.T) {
+	expiry := testNow.Add(time.Hour)
+	order, err := newTestOrder(testProduct()).
+		Buy().
+		Limit(decimal.RequireFromString("60000")).
+		Size(decimal.RequireFromString("0.01")).
+		GTD(expiry).
+		PostOnly().
+		DisplaySize(decimal.RequireFromString("0.005")).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if order.ExpiryTime.String() != "2026-03-01T13:00:00Z" || order.TimeInForce != model.TimeInForceGoodUntilTime || !order.PostOnly {
+		t.Errorf("unexpected order: %+v", order)
+	}
+	if order.DisplayBaseSize != "0.005" {
+		t.Errorf("unexpected display size: %s", order.DisplayBaseSize)
+	}
+}
+
+func TestOrderBuilderTwap(t *testing.T) {
+	order, err := newTestOrder(testProduct()).
+		Sell().
+		Twap(decimal.RequireFromString("60000"), testNow.Add(time.Minute), testNow.Add(2*time.Hour)).
+		Size(decimal.RequireFromString("2")).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order.StartTime.String() != "2026-03-01T12:01:00Z" || order.ExpiryTime.String() != "2026-03-01T14:00:00Z" {
+		t.Errorf("unexpected times: %s - %s", order.StartTime, order.ExpiryTime)
+	}
+}
+
+func TestOrderBuilderComparesRoundedStopLimitPrices(t *testing.T) {
+	// Both prices round up to 100.5, so the sent order is valid even though the raw limit is above the stop
+	order, err := newTestOrder(testProduct()).
+		Sell().
+		StopLimit(decimal.RequireFromString("100.2"), decimal.RequireFromString("100.4")).
+		Size(decimal.RequireFromString("1")).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order.StopPrice != "100.5" || order.LimitPrice != "100.5" {
+		t.Errorf("unexpected prices: stop %s, limit %s", order.StopPrice, order.LimitPrice)
+	}
+}
+
+func TestOrderBuilderReportsAllViolations(t *testing.T) {
+	cases := []struct {
+		description string
+		builder     *OrderBuilder
+		fields      []string
+	}{
+		{
+			description: "MissingEverything",
+			builder:     NewOrder(nil),
+			fields:      []string{"product_id", "portfolio_id", "side", "type", "base_quantity"},
+		},
+		{
+			description: "PostOnlyIoc",
+			builder:     newTestOrder(testProduct()).Buy().Limit(decimal.RequireFromString("1")).Size(decimal.RequireFromString("1")).IOC().PostOnly(),
+			fields:      []string{"post_only"},
+		},
+		{
+			description: "MarketWithPostOnlyAndDisplaySize",
+			builder:     newTestOrder(testProduct()).Buy().Market().Size(decimal.RequireFromString("1")).PostOnly().DisplaySize(decimal.RequireFromString("0.5")),
+			fields:      []string{"post_only", "display_base_size"},
+		},
+		{
+			description: "BelowMinimumAfterRounding",
+			builder:     newTestOrder(testProduct()).Sell().Limit(decimal.RequireFromString("1")).Size(decimal.RequireFromString("0.00005")),
+			fields:      []string{"base_quantity"},
+		},
+		{
+			description: "AboveMaximumQuote",
+			builder:     newTestOrder(testProduct()).Buy().Market().QuoteSize(decimal.RequireFromString("2000000")),
+			fields:      []string{"quote_value"},
+		},
+		{
+			description: "GtdInThePast",
+			builder:     newTestOrder(testProduct()).Buy().Limit(decimal.RequireFromString("1")).Size(decimal.RequireFromString("1")).GTD(testNow.Add(-time.Minute)),
+			fields:      []string{"expiry_time"},
+		},
+		{
+			description: "StopLimitInverted",
+			builder:     newTestOrder(testProduct()).Sell().StopLimit(decimal.RequireFromString("100"), decimal.RequireFromString("110")).Size(decimal.RequireFromString("1")),
+			fields:      []string{"limit_price"},
+		},
+		{
+			description: "TwapWithoutEnd",
+			builder:     newTestOrder(testProduct()).Buy().Twap(decimal.RequireFromString("1"), testNow, time.Time{}).Size(decimal.RequireFromString("1")),
+			fields:      []string{"expiry_time"},
+		},
+		{
+			description: "BothSizes",
+			builder:     newTestOrder(testProduct()).Buy().Market().Size(decimal.RequireFromString("1")).QuoteSize(decimal.RequireFromString("10")),
+			fields:      []string{"base_quantity"},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			fields := violationFields(t, err)
+			for _, f := range tt.fields {
+				if !fields[f] {
+					t.Errorf("expected violation on %s, got %v", f, err)
+				}
+			}
+			if len(fields) != len(tt.fields) {
+				t.Errorf("expected violations on %v, got %v", tt.fields, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/utils"
+	"github.com/shopspring/decimal"
+)
+
+// RFQ rejection reasons reported on RFQAttempt
+const (
+	RFQRejectExpired      = "EXPIRED"
+	RFQRejectLimitPrice   = "LIMIT_PRICE"
+	RFQRejectSlippage     = "SLIPPAGE"
+	RFQRejectAcceptFailed = "ACCEPT_FAILED"
+	RFQRejectInvalidQuote = "INVALID_QUOTE"
+)
+
+// RFQRequest describes a quote to request and the guards a quote must pass to be accepted
+type RFQRequest struct {
+	PortfolioId  string
+	ProductId    string
+	Side         model.OrderSide
+	BaseQuantity string
+	QuoteValue   string
+	// LimitPrice is the worst acceptable price: the maximum for buys, the minimum for sells (required)
+	LimitPrice      string
+	SettleCurrency  string
+	QuoteDurationMs string
+	// ClientOrderId is used for every AcceptQuote call (generated when empty)
+	ClientOrderId string
+	// MaxSlippage is the tolerated fraction between the quote and the CreateOrderPreview average
+	// price for a market order of the same size, e.g. 0.002 for 20bps (0 disables the check)
+	MaxSlippage decimal.Decimal
+	// MaxQuotes is the number of quotes requested before giving up (default 3)
+	MaxQuotes int
+	// ClockSkew is added to the local clock to estimate the server clock
+	ClockSkew time.Duration
+	// ExpiryBuffer is the minimum time a quote must have left to be accepted (default 500ms)
+	ExpiryBuffer time.Duration
+}
+
+// RFQAttempt records one quote and why it was not accepted
+type RFQAttempt struct {
+	Quote *CreateQuoteResponse
+	// Reject is empty for the accepted quote
+	Reject string
+	Err    error
+}
+
+// RFQOutcome is the result of an RFQ run
+type RFQOutcome struct {
+	Accepted      bool
+	OrderId       string
+	ClientOrderId string
+	// Quote is the accepted quote
+	Quote *CreateQuoteResponse
+	// ReferencePrice is the preview average price used for the slippage check
+	ReferencePrice string
+	Attempts       []*RFQAttempt
+}
+
+// RFQ requests quotes and accepts the first one that has not expired, is within the limit price and,
+// if MaxSlippage is set, within tolerance of a market order preview. Rejected quotes are re-requested
+// up to MaxQuotes times. An ambiguous AcceptQuote failure is resolved by looking the order up by client
+// order id, so a quote is never accepted twice. Quotes failing the guards are reported on the outcome
+// rather than as an error; the error is reserved for failed API calls and invalid requests.
+func RFQ(ctx context.Context, service OrdersService, request *RFQRequest) (*RFQOutcome, error) {
+	if request == nil {
+		return nil, errors.New("rfq request not set")
+	}
+
+	limit, err := decimal.NewFromString(request.LimitPrice)
+	if err != nil || !limit.IsPositive() {
+		return nil, fmt.Errorf("invalid rfq limit price: %s", request.LimitPrice)
+	}
+	if request.Side != model.OrderSideBuy && request.Side != model.OrderSideSell {
+		return nil, fmt.Errorf("invalid rfq side: %s", request.Side)
+	}
+
+	cfg := *request
+	if cfg.MaxQuotes <= 0 {
+		cfg.MaxQuotes = 3
+	}
+	if cfg.ExpiryBuffer <= 0 {
+		cfg.ExpiryBuffer = 500 * time.Millisecond
+	}
+	if len(cfg.ClientOrderId) == 0 {
+		cfg.ClientOrderId = utils.NewUuid()
+	}
+
+	outcome := &RFQOutcome{ClientOrderId: cfg.ClientOrderId}
+
+	var reference decimal.Decimal
+	if cfg.MaxSlippage.IsPositive() {
+		if reference, err = rfqReferencePrice(ctx, service, &cfg); err != nil {
+			return outcome, err
+		}
+		outcome.ReferencePrice = reference.String()
+	}
+
+	started := time.Now()
+
+	for len(outcome.Attempts) < cfg.MaxQuotes {
+		quote, err := service.CreateQuoteRequest(ctx, &CreateQuoteRequest{
+			PortfolioId:     cfg.PortfolioId,
+			ProductId:       cfg.ProductId,
+			Side:            cfg.Side,
+			ClientQuoteId:   utils.NewUuid(),
+			BaseQuantity:    cfg.BaseQuantity,
+			QuoteValue:      cfg.QuoteValue,
+			LimitPrice:      cfg.LimitPrice,
+			SettleCurrency:  cfg.SettleCurrency,
+			QuoteDurationMs: cfg.QuoteDurationMs,
+		})
+		if err != nil {
+			return outcome, fmt.Errorf("unable to request quote: %w", err)
+		}
+
+		attempt := &RFQAttempt{Quote: quote}
+		outcome.Attempts = append(outcome.Attempts, attempt)
+
+		if attempt.Reject, attempt.Err = checkQuote(quote, &cfg, limit, reference); len(attempt.Reject) > 0 {
+			continue
+		}
+
+		resp, err := service.AcceptQuote(ctx, &AcceptQuoteRequest{
+			PortfolioId:   cfg.PortfolioId,
+			ProductId:     cfg.ProductId,
+			Side:          string(cfg.Side),
+			ClientOrderId: cfg.ClientOrderId,
+			QuoteId:       quote.QuoteId,
+		})
+		if err == nil {
+			outcome.Accepted = true
+			outcome.OrderId = resp.OrderId
+			outcome.Quote = quote
+			return outcome, nil
+		}
+
+		attempt.Reject = RFQRejectAcceptFailed
+		attempt.Err = err
+
+		if !IsAmbiguousSubmitError(err) {
+			continue
+		}
+
+		existing, lookupErr := FindOrderByClientOrderId(ctx, service, &model.Order{
+			PortfolioId:   cfg.PortfolioId,
+			ProductId:     cfg.ProductId,
+			ClientOrderId: cfg.ClientOrderId,
+		}, started.Add(-time.Minute))
+		if lookupErr != nil {
+			return outcome, fmt.Errorf("quote %s may be accepted, lookup failed: %w (accept error: %v)", quote.QuoteId, lookupErr, err)
+		}
+		if existing != nil {
+			attempt.Reject, attempt.Err = "", nil
+			outcome.Accepted = true
+			outcome.OrderId = existing.Id
+			outcome.Quote = quote
+			return outcome, nil
+		}
+	}
+
+	return outcome, nil
+}
+
+// checkQuote returns the reason a quote must not be accepted, or an empty string
+func checkQuote(quote *CreateQuoteResponse, cfg *RFQRequest, limit, reference decimal.Decimal) (string, error) {
+	expires, err := time.Parse(time.RFC3339Nano, quote.ExpirationTime)
+	if err != nil {
+		return RFQRejectInvalidQuote, fmt.Errorf("invalid expiration time: %s", quote.ExpirationTime)
+	}
+	if remaining := expires.Sub(time.Now().Add(cfg.ClockSkew)); remaining < cfg.ExpiryBuffer {
+		return RFQRejectExpired, fmt.Errorf("quote expires in %s", remaining)
+	}
+
+	// Quote responses have no generated accessors, and an empty best price must reject the quote
+	// rather than read as zero
+	price, err := decimal.NewFromString(quote.BestPrice)
+	if err != nil {
+		return RFQRejectInvalidQuote, fmt.Errorf("invalid best price: %s", quote.BestPrice)
+	}
+
+	buy := cfg.Side == model.OrderSideBuy
+	if (buy && price.GreaterThan(limit)) || (!buy && price.LessThan(limit)) {
+		return RFQRejectLimitPrice, fmt.Errorf("quote price %s is worse than limit %s", price, limit)
+	}
+
+	if cfg.MaxSlippage.IsPositive() {
+		bound := reference.Mul(decimal.NewFromInt(1).Add(cfg.MaxSlippage))
+		if !buy {
+			bound = reference.Mul(decimal.NewFromInt(1).Sub(cfg.MaxSlippage))
+		}
+		if (buy && price.GreaterThan(bound)) || (!buy && price.LessThan(bound)) {
+			return RFQRejectSlippage, fmt.Errorf("quote price %s is outside slippage bound %s", price, bound)
+		}
+	}
+
+	return "", nil
+}
+
+// rfqReferencePrice previews a market order of the same size and returns its average price
+func rfqReferencePrice(ctx context.Context, service OrdersService, cfg *RFQRequest) (decimal.Decimal, error) {
+	preview, err := service.CreateOrderPreview(ctx, &CreateOrderRequest{Order: &model.Order{
+		PortfolioId:  cfg.PortfolioId,
+		ProductId:    cfg.ProductId,
+		Side:         string(cfg.Side),
+		Type:         model.OrderTypeMarket,
+		BaseQuantity: cfg.BaseQuantity,
+		QuoteValue:   cfg.QuoteValue,
+	}})
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("unable to preview order: %w", err)
+	}
+
+	if preview.Order == nil {
+		return decimal.Zero, errors.New("order preview returned no order")
+	}
+
+	price, err := preview.Order.AverageFilledPriceNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !price.IsPositive() {
+		return decimal.Zero, fmt.Errorf("invalid preview average price: %q", preview.Order.AverageFilledPrice)
+	}
+	return price, nil
+}
@@ -0,0 +1,178 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/core-go"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+// quoteSequence returns the given best prices in order, each valid for ttl
+func quoteSequence(ttl time.Duration, prices ...string) func(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	n := 0
+	return func(ctx context.Context, r *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+		if r.ClientQuoteId == "" {
+			return nil, errors.New("client quote id not set")
+		}
+		price := prices[n]
+		n++
+		return &CreateQuoteResponse{
+			QuoteId:        "q" + price,
+			BestPrice:      price,
+			ExpirationTime: time.Now().Add(ttl).UTC().Format(time.RFC3339Nano),
+			Request:        r,
+		}, nil
+	}
+}
+
+func TestRFQRequotesUntilWithinSlippage(t *testing.T) {
+	var accepted []string
+	svc := &stubOrdersService{
+		previewOrder: func(ctx context.Context, r *CreateOrderRequest) (*CreateOrderPreviewResponse, error) {
+			if r.Order.Type != model.OrderTypeMarket {
+				t.Fatalf("unexpected preview: %+v", r.Order)
+			}
+			return &CreateOrderPreviewResponse{Order: &model.Order{AverageFilledPrice: "100"}}, nil
+		},
+		createQuote: quoteSequence(time.Minute, "101", "100.1"),
+		acceptQuote: func(ctx context.Context, r *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+			accepted = append(accepted, r.QuoteId)
+			return &AcceptQuoteResponse{OrderId: "o1"}, nil
+		},
+	}
+
+	outcome, err := RFQ(context.Background(), svc, &RFQRequest{
+		PortfolioId:  "p1",
+		ProductId:    "BTC-USD",
+		Side:         model.OrderSideBuy,
+		BaseQuantity: "1",
+		LimitPrice:   "105",
+		MaxSlippage:  decimal.RequireFromString("0.005"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !outcome.Accepted || outcome.OrderId != "o1" || outcome.Quote.QuoteId != "q100.1" || outcome.ReferencePrice != "100" {
+		t.Errorf("unexpected outcome: %+v", outcome)
+	}
+	if len(outcome.Attempts) != 2 || outcome.Attempts[0].Reject != RFQRejectSlippage || outcome.Attempts[1].Reject != "" {
+		t.Errorf("unexpected attempts: %+v", outcome.Attempts)
+	}
+	if len(accepted) != 1 {
+		t.Errorf("expected a single accept, got %v", accepted)
+	}
+}
+
+func TestRFQRejectsEmptyPreview(t *testing.T) {
+	svc := &stubOrdersService{
+		previewOrder: func(ctx context.Context, r *CreateOrderRequest) (*CreateOrderPreviewResponse, error) {
+			return &CreateOrderPreviewResponse{}, nil
+		},
+		createQuote: quoteSequence(time.Minute, "100"),
+	}
+
+	_, err := RFQ(context.Background(), svc, &RFQRequest{
+		PortfolioId:  "p1",
+		ProductId:    "BTC-USD",
+		Side:         model.OrderSideBuy,
+		BaseQuantity: "1",
+		LimitPrice:   "105",
+		MaxSlippage:  decimal.RequireFromString("0.005"),
+	})
+	if err == nil {
+		t.Fatal("expected error for an empty preview")
+	}
+}
+
+func TestRFQRejectsExpiredAndLimitPrice(t *testing.T) {
+	svc := &stubOrdersService{
+		createQuote: func() func(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+			expiring := quoteSequence(100*time.Millisecond, "90")
+			fresh := quoteSequence(time.Minute, "89")
+			n := 0
+			return func(ctx context.Context, r *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+				n++
+				if n == 1 {
+					return expiring(ctx, r)
+				}
+				return fresh(ctx, r)
+			}
+		}(),
+	}
+
+	outcome, err := RFQ(context.Background(), svc, &RFQRequest{
+		PortfolioId:  "p1",
+		Side:         model.OrderSideSell,
+		BaseQuantity: "1",
+		LimitPrice:   "89.5",
+		MaxQuotes:    2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outcome.Accepted || len(outcome.Attempts) != 2 {
+		t.Fatalf("unexpected outcome: %+v", outcome)
+	}
+	if outcome.Attempts[0].Reject != RFQRejectExpired || outcome.Attempts[1].Reject != RFQRejectLimitPrice {
+		t.Errorf("unexpected rejects: %s, %s", outcome.Attempts[0].Reject, outcome.Attempts[1].Reject)
+	}
+}
+
+func TestRFQResolvesAmbiguousAccept(t *testing.T) {
+	svc := &stubOrdersService{
+		createQuote: quoteSequence(time.Minute, "100"),
+		acceptQuote: func(ctx context.Context, r *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+			return nil, &core.ApiError{CodeReceived: http.StatusGatewayTimeout}
+		},
+		listOpenOrders: func(ctx context.Context, r *ListOpenOrdersRequest) (*ListOpenOrdersResponse, error) {
+			return &ListOpenOrdersResponse{}, nil
+		},
+		listOrders: func(ctx context.Context, r *ListOrdersRequest) (*ListOrdersResponse, error) {
+			return &ListOrdersResponse{Orders: []*model.Order{{Id: "filled", ClientOrderId: "c1"}}, Request: r}, nil
+		},
+	}
+
+	outcome, err := RFQ(context.Background(), svc, &RFQRequest{
+		PortfolioId:   "p1",
+		Side:          model.OrderSideBuy,
+		BaseQuantity:  "1",
+		LimitPrice:    "100",
+		ClientOrderId: "c1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !outcome.Accepted || outcome.OrderId != "filled" || len(outcome.Attempts) != 1 {
+		t.Errorf("unexpected outcome: %+v", outcome)
+	}
+}
+
+func TestRFQValidatesRequest(t *testing.T) {
+	if _, err := RFQ(context.Background(), &stubOrdersService{}, &RFQRequest{Side: model.OrderSideBuy}); err == nil {
+		t.Error("expected limit price error")
+	}
+	if _, err := RFQ(context.Background(), &stubOrdersService{}, &RFQRequest{LimitPrice: "1"}); err == nil {
+		t.Error("expected side error")
+	}
+}
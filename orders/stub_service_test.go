@@ -0,0 +1,78 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"context"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+// stubOrdersService implements OrdersService with overridable funcs. Calls without a func panic
+// through the nil embedded interface, which flags unexpected calls in tests.
+type stubOrdersService struct {
+	OrdersService
+	getOrder       func(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
+	listOrderFills func(context.Context, *ListOrderFillsRequest) (*ListOrderFillsResponse, error)
+	listOpenOrders func(context.Context, *ListOpenOrdersRequest) (*ListOpenOrdersResponse, error)
+	cancelOrder    func(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	createOrder    func(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	listOrders     func(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	createQuote    func(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error)
+	acceptQuote    func(context.Context, *AcceptQuoteRequest) (*AcceptQuoteResponse, error)
+	previewOrder   func(context.Context, *CreateOrderRequest) (*CreateOrderPreviewResponse, error)
+}
+
+func (s *stubOrdersService) GetOrder(ctx context.Context, request *GetOrderRequest) (*GetOrderResponse, error) {
+	return s.getOrder(ctx, request)
+}
+
+func (s *stubOrdersService) ListOrderFills(ctx context.Context, request *ListOrderFillsRequest) (*ListOrderFillsResponse, error) {
+	return s.listOrderFills(ctx, request)
+}
+
+func (s *stubOrdersService) ListOpenOrders(ctx context.Context, request *ListOpenOrdersRequest) (*ListOpenOrdersResponse, error) {
+	return s.listOpenOrders(ctx, request)
+}
+
+func (s *stubOrdersService) CancelOrder(ctx context.Context, request *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return s.cancelOrder(ctx, request)
+}
+
+func (s *stubOrdersService) CreateOrder(ctx context.Context, request *CreateOrderRequest) (*CreateOrderResponse, error) {
+	return s.createOrder(ctx, request)
+}
+
+func (s *stubOrdersService) ListOrders(ctx context.Context, request *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return s.listOrders(ctx, request)
+}
+
+func (s *stubOrdersService) CreateQuoteRequest(ctx context.Context, request *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	return s.createQuote(ctx, request)
+}
+
+func (s *stubOrdersService) AcceptQuote(ctx context.Context, request *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+	return s.acceptQuote(ctx, request)
+}
+
+func (s *stubOrdersService) CreateOrderPreview(ctx context.Context, request *CreateOrderRequest) (*CreateOrderPreviewResponse, error) {
+	return s.previewOrder(ctx, request)
+}
+
+func (s *stubOrdersService) ServiceConfig() *model.ServiceConfig {
+	return model.DefaultServiceConfig()
+}
@@ -0,0 +1,242 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/coinbase-samples/core-go"
+	"github.com/coinbase-samples/prime-sdk-go/client"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/utils"
+)
+
+// SubmitOrderOptions controls how SubmitOrder retries
+type SubmitOrderOptions struct {
+	// MaxAttempts is the number of CreateOrder calls made before giving up (default 3)
+	MaxAttempts int
+	// RetryDelay is the wait before looking up or resubmitting after a failure (default 1s)
+	RetryDelay time.Duration
+	// Lookback bounds how far back ListOrders is searched for the client order id (default 1h)
+	Lookback time.Duration
+}
+
+// DefaultSubmitOrderOptions returns options that try up to 3 times, 1s apart
+func DefaultSubmitOrderOptions() *SubmitOrderOptions {
+	return &SubmitOrderOptions{
+		MaxAttempts: 3,
+		RetryDelay:  time.Second,
+		Lookback:    time.Hour,
+	}
+}
+
+// SubmitOrderResult is the outcome of SubmitOrder
+type SubmitOrderResult struct {
+	OrderId       string
+	ClientOrderId string
+	// Attempts is the number of CreateOrder calls made
+	Attempts int
+	// Existing is true when the order id was found by client order id after an ambiguous failure
+	// or a duplicate client order id rejection
+	Existing bool
+}
+
+// IsAmbiguousSubmitError returns true when a CreateOrder failure leaves it unknown whether the
+// order was accepted: transport failures, timeouts, 5xx responses and success responses that
+// could not be decoded. Errors raised before the request was sent, such as validation or
+// marshal errors, are not ambiguous.
+func IsAmbiguousSubmitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var apiErr *core.ApiError
+	if errors.As(err, &apiErr) {
+		if apiErr.CodeReceived >= http.StatusInternalServerError {
+			return true
+		}
+		// core reports failed round trips without a status code or URL; an invalid URL carries
+		// the URL it failed to parse
+		return apiErr.CodeReceived == 0 && len(apiErr.ParsedUrl) == 0
+	}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &syntaxErr) || errors.As(err, &typeErr)
+}
+
+// IsDuplicateClientOrderIdError returns true when Prime rejected an order because its client
+// order id was already used
+func IsDuplicateClientOrderIdError(err error) bool {
+	var apiErr *core.ApiError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.CodeReceived == http.StatusConflict {
+		return true
+	}
+	message := strings.ToLower(apiErr.Message)
+	return apiErr.CodeReceived >= http.StatusBadRequest && apiErr.CodeReceived < http.StatusInternalServerError &&
+		strings.Contains(message, "duplicate") && strings.Contains(strings.ReplaceAll(message, "_", " "), "client order id")
+}
+
+// SubmitOrder creates order and is safe to call repeatedly with the same ClientOrderId.
+// A client order id is generated when empty and reused on every attempt. After an ambiguous
+// failure the order is looked up by client order id, and its id is returned if Prime has it;
+// otherwise the order is resubmitted. When Prime rejects the client order id as a duplicate,
+// because an earlier call or attempt created it, the existing order is looked up and returned.
+// Rate limited attempts are retried without a lookup, and any other failure is returned as is,
+// since Prime definitively rejected the order.
+func SubmitOrder(ctx context.Context, service OrdersService, order *model.Order, opts *SubmitOrderOptions) (*SubmitOrderResult, error) {
+	if order == nil {
+		return nil, errors.New("order not set")
+	}
+
+	cfg := *DefaultSubmitOrderOptions()
+	if opts != nil {
+		if opts.MaxAttempts > 0 {
+			cfg.MaxAttempts = opts.MaxAttempts
+		}
+		if opts.RetryDelay > 0 {
+			cfg.RetryDelay = opts.RetryDelay
+		}
+		if opts.Lookback > 0 {
+			cfg.Lookback = opts.Lookback
+		}
+	}
+
+	req := *order
+	if len(req.ClientOrderId) == 0 {
+		req.ClientOrderId = utils.NewUuid()
+	}
+
+	result := &SubmitOrderResult{ClientOrderId: req.ClientOrderId}
+	since := time.Now().Add(-cfg.Lookback)
+
+	for {
+		result.Attempts++
+		resp, err := service.CreateOrder(ctx, &CreateOrderRequest{Order: &req})
+		if err == nil {
+			result.OrderId = resp.OrderId
+			return result, nil
+		}
+
+		if IsDuplicateClientOrderIdError(err) {
+			existing, lookupErr := FindOrderByClientOrderId(ctx, service, &req, since)
+			if lookupErr != nil {
+				return result, fmt.Errorf("order %s exists, lookup failed: %w (submit error: %v)", req.ClientOrderId, lookupErr, err)
+			}
+			if existing == nil {
+				return result, fmt.Errorf("order %s rejected as a duplicate but not found since %s: %w", req.ClientOrderId, since.Format(time.RFC3339), err)
+			}
+			result.OrderId = existing.Id
+			result.Existing = true
+			return result, nil
+		}
+
+		ambiguous := IsAmbiguousSubmitError(err)
+		if !ambiguous && !client.IsRateLimited(err) {
+			return result, err
+		}
+
+		if err := sleepContext(ctx, cfg.RetryDelay); err != nil {
+			return result, err
+		}
+
+		if ambiguous {
+			existing, lookupErr := FindOrderByClientOrderId(ctx, service, &req, since)
+			if lookupErr != nil {
+				return result, fmt.Errorf("order %s may exist, lookup failed: %w (submit error: %v)", req.ClientOrderId, lookupErr, err)
+			}
+			if existing != nil {
+				result.OrderId = existing.Id
+				result.Existing = true
+				return result, nil
+			}
+		}
+
+		if result.Attempts >= cfg.MaxAttempts {
+			return result, fmt.Errorf("unable to submit order %s after %d attempts: %w", req.ClientOrderId, result.Attempts, err)
+		}
+	}
+}
+
+// FindOrderByClientOrderId searches the open orders and then the orders created since start for
+// an order with the same portfolio, product and client order id. It returns nil if none is found.
+func FindOrderByClientOrderId(ctx context.Context, service OrdersService, order *model.Order, start time.Time) (*model.Order, error) {
+	var productIds []string
+	if len(order.ProductId) > 0 {
+		productIds = []string{order.ProductId}
+	}
+
+	open, err := service.ListOpenOrders(ctx, &ListOpenOrdersRequest{
+		PortfolioId: order.PortfolioId,
+		ProductIds:  productIds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list open orders: %w", err)
+	}
+	for _, o := range open.Orders {
+		if o.ClientOrderId == order.ClientOrderId {
+			return o, nil
+		}
+	}
+
+	resp, err := service.ListOrders(ctx, &ListOrdersRequest{
+		PortfolioId: order.PortfolioId,
+		ProductIds:  productIds,
+		Start:       start,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list orders: %w", err)
+	}
+
+	var found *model.Order
+	_, err = resp.Iterator().WithConfig(nil).WithStopWhen(func(o *model.Order) bool {
+		if o.ClientOrderId == order.ClientOrderId {
+			found = o
+			return true
+		}
+		return false
+	}).FetchAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list orders: %w", err)
+	}
+
+	return found, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
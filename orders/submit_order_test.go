@@ -0,0 +1,186 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/core-go"
+	"github.com/coinbase-samples/prime-sdk-go/client"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+var fastSubmitOptions = &SubmitOrderOptions{RetryDelay: time.Millisecond}
+
+// acceptingVenue accepts every CreateOrder but can fail the response after accepting
+type acceptingVenue struct {
+	accepted   []*model.Order
+	closed     []*model.Order
+	createErrs []error
+	creates    int
+}
+
+func (v *acceptingVenue) service() *stubOrdersService {
+	return &stubOrdersService{
+		createOrder: func(ctx context.Context, r *CreateOrderRequest) (*CreateOrderResponse, error) {
+			n := v.creates
+			v.creates++
+			if n < len(v.createErrs) {
+				if err := v.createErrs[n]; client.IsRateLimited(err) {
+					return nil, err
+				} else if err != nil {
+					// Accepted, but the response was lost
+					v.accept(r.Order)
+					return nil, err
+				}
+			}
+			return &CreateOrderResponse{OrderId: v.accept(r.Order).Id}, nil
+		},
+		listOpenOrders: func(ctx context.Context, r *ListOpenOrdersRequest) (*ListOpenOrdersResponse, error) {
+			return &ListOpenOrdersResponse{Orders: v.accepted}, nil
+		},
+		listOrders: func(ctx context.Context, r *ListOrdersRequest) (*ListOrdersResponse, error) {
+			return &ListOrdersResponse{Orders: v.closed, Request: r}, nil
+		},
+	}
+}
+
+func (v *acceptingVenue) accept(order *model.Order) *model.Order {
+	o := *order
+	o.Id = "order-" + order.ClientOrderId
+	v.accepted = append(v.accepted, &o)
+	return &o
+}
+
+func TestSubmitOrderFindsAcceptedOrderAfterTimeout(t *testing.T) {
+	venue := &acceptingVenue{createErrs: []error{context.DeadlineExceeded}}
+
+	result, err := SubmitOrder(context.Background(), venue.service(), &model.Order{PortfolioId: "p1", ProductId: "BTC-USD"}, fastSubmitOptions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Existing || result.Attempts != 1 || result.ClientOrderId == "" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(venue.accepted) != 1 || result.OrderId != venue.accepted[0].Id {
+		t.Errorf("expected a single order, got %+v", venue.accepted)
+	}
+}
+
+func TestSubmitOrderFindsClosedOrder(t *testing.T) {
+	venue := &acceptingVenue{closed: []*model.Order{{Id: "filled-1", ClientOrderId: "c1"}}}
+	svc := venue.service()
+	svc.createOrder = func(ctx context.Context, r *CreateOrderRequest) (*CreateOrderResponse, error) {
+		return nil, &core.ApiError{CodeReceived: http.StatusBadGateway}
+	}
+
+	result, err := SubmitOrder(context.Background(), svc, &model.Order{PortfolioId: "p1", ClientOrderId: "c1"}, fastSubmitOptions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.OrderId != "filled-1" || !result.Existing {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestSubmitOrderRetriesRateLimitedWithSameClientOrderId(t *testing.T) {
+	venue := &acceptingVenue{createErrs: []error{&core.ApiError{CodeReceived: http.StatusTooManyRequests}}}
+
+	result, err := SubmitOrder(context.Background(), venue.service(), &model.Order{PortfolioId: "p1", ClientOrderId: "c1"}, fastSubmitOptions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Attempts != 2 || result.Existing || result.OrderId != "order-c1" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestSubmitOrderReturnsRejection(t *testing.T) {
+	rejected := &core.ApiError{CodeReceived: http.StatusBadRequest, Message: "invalid size"}
+	svc := &stubOrdersService{
+		createOrder: func(ctx context.Context, r *CreateOrderRequest) (*CreateOrderResponse, error) {
+			return nil, rejected
+		},
+	}
+
+	result, err := SubmitOrder(context.Background(), svc, &model.Order{PortfolioId: "p1"}, fastSubmitOptions)
+	if !errors.Is(err, rejected) || result.Attempts != 1 {
+		t.Errorf("expected rejection without retry, got %+v, %v", result, err)
+	}
+}
+
+func TestSubmitOrderGivesUpAfterMaxAttempts(t *testing.T) {
+	svc := &stubOrdersService{
+		createOrder: func(ctx context.Context, r *CreateOrderRequest) (*CreateOrderResponse, error) {
+			return nil, &core.ApiError{Message: "connection reset"}
+		},
+		listOpenOrders: func(ctx context.Context, r *ListOpenOrdersRequest) (*ListOpenOrdersResponse, error) {
+			return &ListOpenOrdersResponse{}, nil
+		},
+		listOrders: func(ctx context.Context, r *ListOrdersRequest) (*ListOrdersResponse, error) {
+			return &ListOrdersResponse{Request: r}, nil
+		},
+	}
+
+	result, err := SubmitOrder(context.Background(), svc, &model.Order{PortfolioId: "p1"}, &SubmitOrderOptions{MaxAttempts: 2, RetryDelay: time.Millisecond})
+	if err == nil || result.Attempts != 2 {
+		t.Errorf("expected failure after 2 attempts, got %+v, %v", result, err)
+	}
+}
+
+func TestSubmitOrderReturnsExistingOrderOnDuplicateClientOrderId(t *testing.T) {
+	venue := &acceptingVenue{closed: []*model.Order{{Id: "filled-1", ClientOrderId: "c1"}}}
+	svc := venue.service()
+	svc.createOrder = func(ctx context.Context, r *CreateOrderRequest) (*CreateOrderResponse, error) {
+		return nil, &core.ApiError{CodeReceived: http.StatusBadRequest, Message: "Duplicate client_order_id"}
+	}
+
+	result, err := SubmitOrder(context.Background(), svc, &model.Order{PortfolioId: "p1", ClientOrderId: "c1"}, fastSubmitOptions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.OrderId != "filled-1" || !result.Existing || result.Attempts != 1 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestIsAmbiguousSubmitError(t *testing.T) {
+	cases := []struct {
+		description string
+		err         error
+		ambiguous   bool
+	}{
+		{description: "transport failure", err: &core.ApiError{Message: "connection reset"}, ambiguous: true},
+		{description: "server error", err: &core.ApiError{CodeReceived: http.StatusServiceUnavailable}, ambiguous: true},
+		{description: "timeout", err: context.DeadlineExceeded, ambiguous: true},
+		{description: "undecodable response", err: &json.SyntaxError{}, ambiguous: true},
+		{description: "invalid url", err: &core.ApiError{Message: "invalid URL", ParsedUrl: "://"}},
+		{description: "rejection", err: &core.ApiError{CodeReceived: http.StatusBadRequest}},
+		{description: "local validation", err: errors.New("order not set on request")},
+	}
+
+	for _, tt := range cases {
+		if got := IsAmbiguousSubmitError(tt.err); got != tt.ambiguous {
+			t.Errorf("%s: expected %t, got %t", tt.description, tt.ambiguous, got)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+// WaitForOrderOptions controls how WaitForOrder polls GetOrder
+type WaitForOrderOptions struct {
+	// MinInterval is the polling interval used right after the order changes (default 250ms)
+	MinInterval time.Duration
+	// MaxInterval caps the polling interval while the order is unchanged (default 5s)
+	MaxInterval time.Duration
+	// Backoff multiplies the interval after each poll without changes (default 1.5)
+	Backoff float64
+	// MaxConsecutiveErrors is the number of GetOrder failures in a row tolerated before giving up (default 3)
+	MaxConsecutiveErrors int
+	// SkipFills disables fetching fills with ListOrderFills once the order is terminal
+	SkipFills bool
+	// OnStatusChange is called when the order status changes. previous is nil on the first poll.
+	OnStatusChange func(previous, current *model.Order)
+	// OnPartialFill is called when the filled quantity of a non-terminal order increases
+	OnPartialFill func(order *model.Order)
+}
+
+// DefaultWaitForOrderOptions returns options that poll between 250ms and 5s
+func DefaultWaitForOrderOptions() *WaitForOrderOptions {
+	return &WaitForOrderOptions{
+		MinInterval:          250 * time.Millisecond,
+		MaxInterval:          5 * time.Second,
+		Backoff:              1.5,
+		MaxConsecutiveErrors: 3,
+	}
+}
+
+// WaitForOrderResult is the final state of an order tracked by WaitForOrder
+type WaitForOrderResult struct {
+	Order *model.Order
+	Fills []*model.OrderFill
+}
+
+// WaitForOrder polls GetOrder until the order reaches a terminal status and returns it with its fills.
+// Polling starts at MinInterval and backs off towards MaxInterval while nothing changes, resetting
+// whenever the status or filled quantity moves. It returns the context error if ctx ends first.
+func WaitForOrder(
+	ctx context.Context,
+	service OrdersService,
+	portfolioId string,
+	orderId string,
+	opts *WaitForOrderOptions,
+) (*WaitForOrderResult, error) {
+	cfg := *DefaultWaitForOrderOptions()
+	if opts != nil {
+		cfg.OnStatusChange = opts.OnStatusChange
+		cfg.OnPartialFill = opts.OnPartialFill
+		cfg.SkipFills = opts.SkipFills
+		if opts.MinInterval > 0 {
+			cfg.MinInterval = opts.MinInterval
+		}
+		if opts.MaxInterval > 0 {
+			cfg.MaxInterval = opts.MaxInterval
+		}
+		if opts.Backoff >= 1 {
+			cfg.Backoff = opts.Backoff
+		}
+		if opts.MaxConsecutiveErrors > 0 {
+			cfg.MaxConsecutiveErrors = opts.MaxConsecutiveErrors
+		}
+	}
+
+	var (
+		previous *model.Order
+		failures int
+		interval = cfg.MinInterval
+		timer    = time.NewTimer(0)
+	)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		resp, err := service.GetOrder(ctx, &GetOrderRequest{PortfolioId: portfolioId, OrderId: orderId})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			failures++
+			if failures >= cfg.MaxConsecutiveErrors {
+				return nil, fmt.Errorf("unable to get order %s: %w", orderId, err)
+			}
+			timer.Reset(interval)
+			continue
+		}
+		failures = 0
+
+		current := resp.Order
+		if current == nil {
+			return nil, fmt.Errorf("order %s not returned", orderId)
+		}
+
+		changed := previous == nil || previous.Status != current.Status
+		if changed && cfg.OnStatusChange != nil {
+			cfg.OnStatusChange(previous, current)
+		}
+
+		if current.Status.IsTerminal() {
+			result := &WaitForOrderResult{Order: current}
+			if !cfg.SkipFills {
+				if result.Fills, err = fetchOrderFills(ctx, service, portfolioId, orderId); err != nil {
+					return result, err
+				}
+			}
+			return result, nil
+		}
+
+		if previous != nil && filledMore(previous, current) {
+			changed = true
+			if cfg.OnPartialFill != nil {
+				cfg.OnPartialFill(current)
+			}
+		}
+
+		if changed {
+			interval = cfg.MinInterval
+		} else {
+			interval = time.Duration(float64(interval) * cfg.Backoff)
+			if interval > cfg.MaxInterval {
+				interval = cfg.MaxInterval
+			}
+		}
+
+		previous = current
+		timer.Reset(interval)
+	}
+}
+
+func filledMore(previous, current *model.Order) bool {
+	before, err := previous.FilledQuantityNum()
+	if err != nil {
+		before = decimal.Zero
+	}
+	after, err := current.FilledQuantityNum()
+	if err != nil {
+		return false
+	}
+	return after.GreaterThan(before)
+}
+
+func fetchOrderFills(ctx context.Context, service OrdersService, portfolioId, orderId string) ([]*model.OrderFill, error) {
+	resp, err := service.ListOrderFills(ctx, &ListOrderFillsRequest{PortfolioId: portfolioId, OrderId: orderId})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list fills for order %s: %w", orderId, err)
+	}
+	return resp.Iterator().WithConfig(nil).FetchAll(ctx)
+}
@@ -0,0 +1,108 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+func fastWaitOptions() *WaitForOrderOptions {
+	return &WaitForOrderOptions{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+}
+
+func TestWaitForOrderTracksToFilled(t *testing.T) {
+	states := []*model.Order{
+		{Id: "o1", Status: model.OrderStatusPending},
+		{Id: "o1", Status: model.OrderStatusOpen, FilledQuantity: "0"},
+		{Id: "o1", Status: model.OrderStatusOpen, FilledQuantity: "0.4"},
+		{Id: "o1", Status: model.OrderStatusOpen, FilledQuantity: "0.4"},
+		{Id: "o1", Status: model.OrderStatusFilled, FilledQuantity: "1"},
+	}
+
+	calls := 0
+	svc := &stubOrdersService{
+		getOrder: func(ctx context.Context, r *GetOrderRequest) (*GetOrderResponse, error) {
+			if r.PortfolioId != "p1" || r.OrderId != "o1" {
+				t.Fatalf("unexpected request: %+v", r)
+			}
+			order := states[calls]
+			calls++
+			if calls == 2 {
+				return nil, errors.New("transient")
+			}
+			return &GetOrderResponse{Order: order}, nil
+		},
+		listOrderFills: func(ctx context.Context, r *ListOrderFillsRequest) (*ListOrderFillsResponse, error) {
+			return &ListOrderFillsResponse{Fills: []*model.OrderFill{{Id: "f1"}, {Id: "f2"}}}, nil
+		},
+	}
+
+	var transitions []model.OrderStatus
+	partials := 0
+	opts := fastWaitOptions()
+	opts.OnStatusChange = func(previous, current *model.Order) {
+		transitions = append(transitions, current.Status)
+	}
+	opts.OnPartialFill = func(order *model.Order) { partials++ }
+
+	result, err := WaitForOrder(context.Background(), svc, "p1", "o1", opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Order.Status != model.OrderStatusFilled || len(result.Fills) != 2 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(transitions) != 3 || transitions[0] != "PENDING" || transitions[2] != "FILLED" {
+		t.Errorf("unexpected transitions: %v", transitions)
+	}
+	if partials != 1 {
+		t.Errorf("expected 1 partial fill callback, got %d", partials)
+	}
+}
+
+func TestWaitForOrderGivesUpAfterErrors(t *testing.T) {
+	svc := &stubOrdersService{
+		getOrder: func(ctx context.Context, r *GetOrderRequest) (*GetOrderResponse, error) {
+			return nil, errors.New("down")
+		},
+	}
+
+	if _, err := WaitForOrder(context.Background(), svc, "p1", "o1", fastWaitOptions()); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestWaitForOrderHonorsContext(t *testing.T) {
+	svc := &stubOrdersService{
+		getOrder: func(ctx context.Context, r *GetOrderRequest) (*GetOrderResponse, error) {
+			return &GetOrderResponse{Order: &model.Order{Status: model.OrderStatusOpen}}, nil
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := WaitForOrder(ctx, svc, "p1", "o1", fastWaitOptions()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,393 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pnl builds tax lots from fill history and computes realized and unrealized PnL.
+// Commissions are folded into lot prices: buys cost price plus commission per unit, sells
+// receive price minus commission per unit, so realized PnL is net of commissions.
+package pnl
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+// Method selects which open lots a closing fill consumes
+type Method string
+
+const (
+	// MethodFIFO closes the oldest lots first
+	MethodFIFO Method = "FIFO"
+	// MethodLIFO closes the newest lots first
+	MethodLIFO Method = "LIFO"
+	// MethodHIFO closes long lots with the highest cost, and short lots with the lowest proceeds, first
+	MethodHIFO Method = "HIFO"
+	// MethodAverageCost keeps one lot per product and direction at the weighted average price
+	MethodAverageCost Method = "AVERAGE_COST"
+)
+
+// Lot direction
+const (
+	Long  = "LONG"
+	Short = "SHORT"
+)
+
+// Lot is an open position opened by a fill
+type Lot struct {
+	// Id is the id of the fill that opened the lot
+	Id        string          `json:"id"`
+	ProductId string          `json:"product_id"`
+	Side      string          `json:"side"`
+	OpenedAt  time.Time       `json:"opened_at"`
+	Quantity  decimal.Decimal `json:"quantity"`
+	// Price is the per-unit cost (long) or proceeds (short) net of commission
+	Price decimal.Decimal `json:"price"`
+}
+
+// Realization is the PnL realized by a fill closing (part of) a lot
+type Realization struct {
+	ProductId   string          `json:"product_id"`
+	LotId       string          `json:"lot_id"`
+	CloseFillId string          `json:"close_fill_id"`
+	Side        string          `json:"side"`
+	OpenedAt    time.Time       `json:"opened_at"`
+	ClosedAt    time.Time       `json:"closed_at"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	OpenPrice   decimal.Decimal `json:"open_price"`
+	ClosePrice  decimal.Decimal `json:"close_price"`
+	PnL         decimal.Decimal `json:"pnl"`
+}
+
+// ErrFillOutOfOrder is returned by Apply for a fill that sorts before a fill already applied
+var ErrFillOutOfOrder = errors.New("fill is older than the fills already applied")
+
+// Ledger tracks lots and realized PnL per product. It is not safe for concurrent use.
+type Ledger struct {
+	method   Method
+	lots     map[string][]*Lot
+	realized []*Realization
+	seen     map[string]bool
+	// last is the latest fill applied, in Apply order
+	last *model.OrderFill
+}
+
+// NewLedger creates an empty ledger using method
+func NewLedger(method Method) (*Ledger, error) {
+	switch method {
+	case MethodFIFO, MethodLIFO, MethodHIFO, MethodAverageCost:
+	default:
+		return nil, fmt.Errorf("unsupported lot method: %s", method)
+	}
+	return &Ledger{
+		method: method,
+		lots:   make(map[string][]*Lot),
+		seen:   make(map[string]bool),
+	}, nil
+}
+
+// Apply books fills in time order, breaking ties by fill id, so results do not depend on the
+// order fills were listed in. Fills already applied are skipped. Later batches must not contain
+// fills older than those already applied; such a fill returns ErrFillOutOfOrder, since booking it
+// late would consume lots in the wrong order. The ledger is unchanged if any fill is invalid.
+func (l *Ledger) Apply(fills []*model.OrderFill) error {
+	sorted := make([]*model.OrderFill, 0, len(fills))
+	batch := make(map[string]bool, len(fills))
+	for _, f := range fills {
+		if !l.seen[f.Id] && !batch[f.Id] {
+			batch[f.Id] = true
+			sorted = append(sorted, f)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return fillBefore(sorted[i], sorted[j]) })
+
+	if len(sorted) > 0 && l.last != nil && fillBefore(sorted[0], l.last) {
+		return fmt.Errorf("fill %s at %s: %w (last applied %s at %s)", sorted[0].Id,
+			sorted[0].Time.Format(time.RFC3339Nano), ErrFillOutOfOrder, l.last.Id, l.last.Time.Format(time.RFC3339Nano))
+	}
+
+	parsed := make([]parsedFill, len(sorted))
+	for i, f := range sorted {
+		p, err := parseFill(f)
+		if err != nil {
+			return fmt.Errorf("invalid fill %s: %w", f.Id, err)
+		}
+		parsed[i] = p
+	}
+
+	for _, p := range parsed {
+		l.book(p)
+		l.seen[p.fill.Id] = true
+		l.last = p.fill
+	}
+	return nil
+}
+
+// fillBefore orders fills by time, breaking ties by id
+func fillBefore(a, b *model.OrderFill) bool {
+	if !a.Time.Equal(b.Time.Time) {
+		return a.Time.Before(b.Time.Time)
+	}
+	return a.Id < b.Id
+}
+
+// Lots returns copies of the open lots of a product, oldest first
+func (l *Ledger) Lots(productId string) []*Lot {
+	lots := make([]*Lot, 0, len(l.lots[productId]))
+	for _, lot := range l.lots[productId] {
+		cp := *lot
+		lots = append(lots, &cp)
+	}
+	sortLots(lots)
+	return lots
+}
+
+// Products returns the ids of products with open lots or realized PnL, sorted
+func (l *Ledger) Products() []string {
+	ids := make(map[string]bool)
+	for id, lots := range l.lots {
+		if len(lots) > 0 {
+			ids[id] = true
+		}
+	}
+	for _, r := range l.realized {
+		ids[r.ProductId] = true
+	}
+
+	sorted := make([]string, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// Realizations returns every realization in the order it was booked
+func (l *Ledger) Realizations() []*Realization {
+	out := make([]*Realization, len(l.realized))
+	for i, r := range l.realized {
+		cp := *r
+		out[i] = &cp
+	}
+	return out
+}
+
+// RealizedPnL returns the realized PnL of a product, or of all products when productId is empty
+func (l *Ledger) RealizedPnL(productId string) decimal.Decimal {
+	total := decimal.Zero
+	for _, r := range l.realized {
+		if len(productId) == 0 || r.ProductId == productId {
+			total = total.Add(r.PnL)
+		}
+	}
+	return total
+}
+
+// Position returns the signed open quantity of a product (negative when short)
+func (l *Ledger) Position(productId string) decimal.Decimal {
+	pos := decimal.Zero
+	for _, lot := range l.lots[productId] {
+		if lot.Side == Long {
+			pos = pos.Add(lot.Quantity)
+		} else {
+			pos = pos.Sub(lot.Quantity)
+		}
+	}
+	return pos
+}
+
+type parsedFill struct {
+	fill *model.OrderFill
+	side string
+	qty  decimal.Decimal
+	// price is net of commission
+	price decimal.Decimal
+}
+
+func parseFill(f *model.OrderFill) (parsedFill, error) {
+	p := parsedFill{fill: f}
+
+	switch f.Side {
+	case string(model.OrderSideBuy):
+		p.side = Long
+	case string(model.OrderSideSell):
+		p.side = Short
+	default:
+		return p, fmt.Errorf("invalid side: %s", f.Side)
+	}
+
+	qty, err := f.FilledQuantityNum()
+	if err != nil {
+		return p, err
+	}
+	if !qty.IsPositive() {
+		return p, fmt.Errorf("invalid filled quantity: %q", f.FilledQuantity)
+	}
+
+	var value decimal.Decimal
+	if len(f.FilledValue) > 0 {
+		if value, err = f.FilledValueNum(); err != nil {
+			return p, err
+		}
+	} else {
+		price, err := f.PriceNum()
+		if err != nil {
+			return p, err
+		}
+		if !price.IsPositive() {
+			return p, fmt.Errorf("invalid price: %q", f.Price)
+		}
+		value = qty.Mul(price)
+	}
+
+	commission, err := f.CommissionNum()
+	if err != nil {
+		return p, err
+	}
+
+	if p.side == Long {
+		value = value.Add(commission)
+	} else {
+		value = value.Sub(commission)
+	}
+
+	p.qty = qty
+	p.price = value.Div(qty)
+	return p, nil
+}
+
+// book closes opposite lots with the fill and opens a lot with any remainder
+func (l *Ledger) book(p parsedFill) {
+	productId := p.fill.ProductId
+	remaining := p.qty
+
+	for remaining.IsPositive() {
+		lot := l.nextToClose(productId, p.side)
+		if lot == nil {
+			break
+		}
+
+		qty := decimal.Min(remaining, lot.Quantity)
+		pnl := p.price.Sub(lot.Price).Mul(qty)
+		if lot.Side == Short {
+			pnl = pnl.Neg()
+		}
+
+		l.realized = append(l.realized, &Realization{
+			ProductId:   productId,
+			LotId:       lot.Id,
+			CloseFillId: p.fill.Id,
+			Side:        lot.Side,
+			OpenedAt:    lot.OpenedAt,
+			ClosedAt:    p.fill.Time.Time,
+			Quantity:    qty,
+			OpenPrice:   lot.Price,
+			ClosePrice:  p.price,
+			PnL:         pnl,
+		})
+
+		lot.Quantity = lot.Quantity.Sub(qty)
+		remaining = remaining.Sub(qty)
+		if lot.Quantity.IsZero() {
+			l.remove(productId, lot)
+		}
+	}
+
+	if remaining.IsPositive() {
+		l.open(&Lot{
+			Id:        p.fill.Id,
+			ProductId: productId,
+			Side:      p.side,
+			OpenedAt:  p.fill.Time.Time,
+			Quantity:  remaining,
+			Price:     p.price,
+		})
+	}
+}
+
+func (l *Ledger) open(lot *Lot) {
+	lots := l.lots[lot.ProductId]
+
+	if l.method == MethodAverageCost {
+		for _, existing := range lots {
+			if existing.Side != lot.Side {
+				continue
+			}
+			total := existing.Quantity.Add(lot.Quantity)
+			existing.Price = existing.Price.Mul(existing.Quantity).Add(lot.Price.Mul(lot.Quantity)).Div(total)
+			existing.Quantity = total
+			return
+		}
+	}
+
+	l.lots[lot.ProductId] = append(lots, lot)
+}
+
+// nextToClose returns the lot a fill on side closes next, or nil if there is none
+func (l *Ledger) nextToClose(productId, side string) *Lot {
+	var best *Lot
+	for _, lot := range l.lots[productId] {
+		if lot.Side == side {
+			continue
+		}
+		if best == nil || l.closesBefore(lot, best) {
+			best = lot
+		}
+	}
+	return best
+}
+
+func (l *Ledger) closesBefore(a, b *Lot) bool {
+	switch l.method {
+	case MethodLIFO:
+		if !a.OpenedAt.Equal(b.OpenedAt) {
+			return a.OpenedAt.After(b.OpenedAt)
+		}
+		return a.Id > b.Id
+	case MethodHIFO:
+		if !a.Price.Equal(b.Price) {
+			if a.Side == Long {
+				return a.Price.GreaterThan(b.Price)
+			}
+			return a.Price.LessThan(b.Price)
+		}
+	}
+	return lotBefore(a, b)
+}
+
+func (l *Ledger) remove(productId string, lot *Lot) {
+	lots := l.lots[productId]
+	for i, existing := range lots {
+		if existing == lot {
+			l.lots[productId] = append(lots[:i], lots[i+1:]...)
+			return
+		}
+	}
+}
+
+func lotBefore(a, b *Lot) bool {
+	if !a.OpenedAt.Equal(b.OpenedAt) {
+		return a.OpenedAt.Before(b.OpenedAt)
+	}
+	return a.Id < b.Id
+}
+
+func sortLots(lots []*Lot) {
+	sort.Slice(lots, func(i, j int) bool { return lotBefore(lots[i], lots[j]) })
+}
@@ -0,0 +1,150 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pnl
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+var t0 = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func fill(id, side, qty, price, commission string, minute int) *model.OrderFill {
+	return &model.OrderFill{
+		Id:             id,
+		ProductId:      "BTC-USD",
+		Side:           side,
+		FilledQuantity: qty,
+		Price:          price,
+		Commission:     commission,
+		Time:           model.NewTimestamp(t0.Add(time.Duration(minute) * time.Minute)),
+	}
+}
+
+func newLedger(t *testing.T, method Method, fills ...*model.OrderFill) *Ledger {
+	t.Helper()
+	l, err := NewLedger(method)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Apply(fills); err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestLotMethods(t *testing.T) {
+	// Listed out of order; Apply sorts by time
+	fills := []*model.OrderFill{
+		fill("f4", "SELL", "2", "300", "", 4),
+		fill("f1", "BUY", "1", "100", "", 1),
+		fill("f3", "BUY", "1", "150", "", 3),
+		fill("f2", "BUY", "1", "200", "", 2),
+	}
+
+	cases := []struct {
+		method    Method
+		realized  string
+		remaining string
+	}{
+		{MethodFIFO, "300", "150"},
+		{MethodLIFO, "250", "100"},
+		{MethodHIFO, "250", "100"},
+		{MethodAverageCost, "300", "150"},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(tc.method), func(t *testing.T) {
+			l := newLedger(t, tc.method, fills...)
+
+			if got := l.RealizedPnL(""); got.String() != tc.realized {
+				t.Errorf("realized = %s; want %s", got, tc.realized)
+			}
+			lots := l.Lots("BTC-USD")
+			if len(lots) != 1 || lots[0].Price.String() != tc.remaining || !lots[0].Quantity.Equal(decimal.NewFromInt(1)) {
+				t.Errorf("unexpected remaining lots: %+v", lots)
+			}
+		})
+	}
+}
+
+func TestCommissionsAndShorts(t *testing.T) {
+	l := newLedger(t, MethodFIFO,
+		fill("b1", "BUY", "1", "100", "1", 1),
+		fill("s1", "SELL", "3", "110", "3", 2),
+		fill("b2", "BUY", "1", "90", "0", 3),
+	)
+
+	// Long: bought at 101 net, sold at 109 net. Short: opened 2 at 109 net, covered 1 at 90.
+	realized := l.Realizations()
+	if len(realized) != 2 || realized[0].PnL.String() != "8" || realized[1].PnL.String() != "19" {
+		t.Fatalf("unexpected realizations: %+v", realized)
+	}
+	if pos := l.Position("BTC-USD"); pos.String() != "-1" {
+		t.Errorf("position = %s; want -1", pos)
+	}
+
+	report, err := l.Report(map[string]decimal.Decimal{"BTC-USD": decimal.NewFromInt(100)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Realized.String() != "27" || report.Unrealized.String() != "9" {
+		t.Errorf("unexpected report totals: %+v", report)
+	}
+	if lots := report.Products[0].Lots; len(lots) != 1 || lots[0].Side != Short || lots[0].UnrealizedPnL.String() != "9" {
+		t.Errorf("unexpected lot valuations: %+v", lots)
+	}
+
+	if _, err := l.Report(nil); err == nil {
+		t.Error("expected missing mark error")
+	}
+}
+
+func TestApplyIsIdempotentAndAtomic(t *testing.T) {
+	l := newLedger(t, MethodFIFO, fill("b1", "BUY", "1", "100", "", 1))
+
+	if err := l.Apply([]*model.OrderFill{fill("b1", "BUY", "1", "100", "", 1), fill("s1", "SELL", "1", "bad", "", 2)}); err == nil {
+		t.Fatal("expected invalid fill error")
+	}
+	if err := l.Apply([]*model.OrderFill{fill("b1", "BUY", "1", "100", "", 1)}); err != nil {
+		t.Fatal(err)
+	}
+	if pos := l.Position("BTC-USD"); pos.String() != "1" {
+		t.Errorf("position = %s; want 1", pos)
+	}
+}
+
+func TestApplyRejectsOlderFills(t *testing.T) {
+	l := newLedger(t, MethodFIFO, fill("b1", "BUY", "1", "100", "", 1), fill("b3", "BUY", "1", "120", "", 3))
+
+	err := l.Apply([]*model.OrderFill{fill("s4", "SELL", "1", "130", "", 4), fill("b2", "BUY", "1", "90", "", 2)})
+	if !errors.Is(err, ErrFillOutOfOrder) {
+		t.Fatalf("expected out of order error, got %v", err)
+	}
+	if pos := l.Position("BTC-USD"); pos.String() != "2" {
+		t.Errorf("position = %s; want 2", pos)
+	}
+
+	// Fills already applied may be repeated, and fills at the same time sort by id
+	if err := l.Apply([]*model.OrderFill{fill("b1", "BUY", "1", "100", "", 1), fill("b4", "BUY", "1", "100", "", 3)}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,88 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pnl
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// LotValuation is an open lot marked to a price
+type LotValuation struct {
+	Lot
+	MarkPrice     decimal.Decimal `json:"mark_price"`
+	UnrealizedPnL decimal.Decimal `json:"unrealized_pnl"`
+}
+
+// ProductReport is the PnL of one product
+type ProductReport struct {
+	ProductId  string          `json:"product_id"`
+	Position   decimal.Decimal `json:"position"`
+	MarkPrice  decimal.Decimal `json:"mark_price"`
+	Realized   decimal.Decimal `json:"realized"`
+	Unrealized decimal.Decimal `json:"unrealized"`
+	Lots       []*LotValuation `json:"lots"`
+}
+
+// Report is the PnL of every product in a ledger
+type Report struct {
+	Method     Method           `json:"method"`
+	Realized   decimal.Decimal  `json:"realized"`
+	Unrealized decimal.Decimal  `json:"unrealized"`
+	Products   []*ProductReport `json:"products"`
+}
+
+// Report marks open lots to marks, keyed by product id, and returns per-product and lot-level PnL.
+// Products are sorted by id and lots oldest first. A product with open lots and no mark is an error.
+// Marks can be taken from candles with products.CandleMarks.
+func (l *Ledger) Report(marks map[string]decimal.Decimal) (*Report, error) {
+	report := &Report{Method: l.method}
+
+	for _, productId := range l.Products() {
+		pr := &ProductReport{
+			ProductId: productId,
+			Position:  l.Position(productId),
+			Realized:  l.RealizedPnL(productId),
+			Lots:      []*LotValuation{},
+		}
+
+		lots := l.Lots(productId)
+		if len(lots) > 0 {
+			mark, ok := marks[productId]
+			if !ok {
+				return nil, fmt.Errorf("no mark price for %s", productId)
+			}
+			pr.MarkPrice = mark
+
+			for _, lot := range lots {
+				pnl := mark.Sub(lot.Price).Mul(lot.Quantity)
+				if lot.Side == Short {
+					pnl = pnl.Neg()
+				}
+				pr.Unrealized = pr.Unrealized.Add(pnl)
+				pr.Lots = append(pr.Lots, &LotValuation{Lot: *lot, MarkPrice: mark, UnrealizedPnL: pnl})
+			}
+		}
+
+		report.Realized = report.Realized.Add(pr.Realized)
+		report.Unrealized = report.Unrealized.Add(pr.Unrealized)
+		report.Products = append(report.Products, pr)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,98 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package products
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoCandle is returned by CandleMarks when a product has no candle at or before the requested time
+var ErrNoCandle = errors.New("no candle")
+
+var candleDurations = map[model.CandleGranularity]time.Duration{
+	model.CandleGranularityOneMinute:      time.Minute,
+	model.CandleGranularityFiveMinutes:    5 * time.Minute,
+	model.CandleGranularityFifteenMinutes: 15 * time.Minute,
+	model.CandleGranularityThirtyMinutes:  30 * time.Minute,
+	model.CandleGranularityOneHour:        time.Hour,
+	model.CandleGranularityTwoHours:       2 * time.Hour,
+	model.CandleGranularityFourHours:      4 * time.Hour,
+	model.CandleGranularitySixHours:       6 * time.Hour,
+	model.CandleGranularityOneDay:         24 * time.Hour,
+}
+
+// CandleMarks returns the close of the latest candle starting at or before at for each product,
+// for use as marks when valuing positions. Candles are requested for the ten intervals before at.
+// A product without such a candle returns an error wrapping ErrNoCandle.
+func CandleMarks(
+	ctx context.Context,
+	service ProductsService,
+	portfolioId string,
+	productIds []string,
+	at time.Time,
+	granularity model.CandleGranularity,
+) (map[string]decimal.Decimal, error) {
+	interval, ok := candleDurations[granularity]
+	if !ok {
+		return nil, fmt.Errorf("unsupported candle granularity: %s", granularity)
+	}
+
+	marks := make(map[string]decimal.Decimal, len(productIds))
+	for _, productId := range productIds {
+		resp, err := service.GetProductCandles(ctx, &GetProductCandlesRequest{
+			PortfolioId: portfolioId,
+			ProductId:   productId,
+			StartTime:   at.Add(-10 * interval),
+			EndTime:     at,
+			Granularity: granularity,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get candles for %s: %w", productId, err)
+		}
+
+		var latest *model.Candle
+		for _, c := range resp.Candles {
+			ts := c.Timestamp.Time
+			if ts.IsZero() {
+				continue
+			}
+			if !ts.After(at) && (latest == nil || ts.After(latest.Timestamp.Time)) {
+				latest = c
+			}
+		}
+		if latest == nil {
+			return nil, fmt.Errorf("%w for %s at %s", ErrNoCandle, productId, at.Format(time.RFC3339))
+		}
+
+		mark, err := latest.CloseNum()
+		if err != nil {
+			return nil, fmt.Errorf("invalid candle close for %s: %w", productId, err)
+		}
+		if !mark.IsPositive() {
+			return nil, fmt.Errorf("invalid candle close for %s: %q", productId, latest.Close)
+		}
+		marks[productId] = mark
+	}
+
+	return marks, nil
+}
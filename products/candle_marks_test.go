@@ -0,0 +1,58 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package products
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+var t0 = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type stubProducts struct {
+	ProductsService
+	candles []*model.Candle
+}
+
+func (s *stubProducts) GetProductCandles(ctx context.Context, r *GetProductCandlesRequest) (*GetProductCandlesResponse, error) {
+	return &GetProductCandlesResponse{Candles: s.candles, Request: r}, nil
+}
+
+func TestCandleMarks(t *testing.T) {
+	svc := &stubProducts{candles: []*model.Candle{
+		{Timestamp: model.NewTimestamp(t0.Add(2 * time.Hour)), Close: "103"},
+		{Timestamp: model.NewTimestamp(t0), Close: "101"},
+		{Timestamp: model.NewTimestamp(t0.Add(time.Hour)), Close: "102"},
+	}}
+
+	marks, err := CandleMarks(context.Background(), svc, "p1", []string{"BTC-USD"}, t0.Add(90*time.Minute), model.CandleGranularityOneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := marks["BTC-USD"]; got.String() != "102" {
+		t.Errorf("mark = %s; want 102", got)
+	}
+
+	_, err = CandleMarks(context.Background(), svc, "p1", []string{"BTC-USD"}, t0.Add(-time.Hour), model.CandleGranularityOneHour)
+	if !errors.Is(err, ErrNoCandle) {
+		t.Errorf("expected ErrNoCandle, got %v", err)
+	}
+}
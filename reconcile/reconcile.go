@@ -0,0 +1,416 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package reconcile proves that the balance changes of a portfolio between two snapshots are
+// explained by the transactions and fills of the interval, and reports what is not.
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/balances"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/coinbase-samples/prime-sdk-go/transactions"
+	"github.com/shopspring/decimal"
+)
+
+// Snapshot is the balance of every symbol of a portfolio at a point in time
+type Snapshot struct {
+	PortfolioId string                     `json:"portfolio_id"`
+	BalanceType string                     `json:"balance_type"`
+	At          time.Time                  `json:"at"`
+	Balances    map[string]decimal.Decimal `json:"balances"`
+}
+
+// Movement is the expected balance change of one symbol caused by a transaction or fill
+type Movement struct {
+	Symbol string          `json:"symbol"`
+	Amount decimal.Decimal `json:"amount"`
+	// TransactionId or FillId identifies the source of the movement
+	TransactionId string `json:"transaction_id,omitempty"`
+	FillId        string `json:"fill_id,omitempty"`
+}
+
+// SymbolReconciliation compares the actual and expected balance change of one symbol
+type SymbolReconciliation struct {
+	Symbol   string          `json:"symbol"`
+	Before   decimal.Decimal `json:"before"`
+	After    decimal.Decimal `json:"after"`
+	Actual   decimal.Decimal `json:"actual"`
+	Expected decimal.Decimal `json:"expected"`
+	// Unexplained is Actual minus Expected
+	Unexplained decimal.Decimal `json:"unexplained"`
+	// Reconciled is true when Unexplained is within the configured tolerance
+	Reconciled     bool     `json:"reconciled"`
+	TransactionIds []string `json:"transaction_ids"`
+	FillIds        []string `json:"fill_ids"`
+	// PendingTransactionIds are transactions in the symbol that had not completed by the end
+	// of the interval; they are not counted but often explain a difference
+	PendingTransactionIds []string `json:"pending_transaction_ids"`
+}
+
+// Report is the reconciliation of every symbol in either snapshot or with a movement
+type Report struct {
+	PortfolioId string                  `json:"portfolio_id"`
+	Start       time.Time               `json:"start"`
+	End         time.Time               `json:"end"`
+	Symbols     []*SymbolReconciliation `json:"symbols"`
+	Movements   []*Movement             `json:"movements"`
+}
+
+// Unexplained returns the symbols that did not reconcile
+func (r *Report) Unexplained() []*SymbolReconciliation {
+	var unexplained []*SymbolReconciliation
+	for _, s := range r.Symbols {
+		if !s.Reconciled {
+			unexplained = append(unexplained, s)
+		}
+	}
+	return unexplained
+}
+
+// Reconciled returns true when every symbol reconciled
+func (r *Report) Reconciled() bool {
+	return len(r.Unexplained()) == 0
+}
+
+// Direction returns +1 when a completed transaction credits the portfolio, -1 when it debits it and 0
+// when it does not change the balance
+type Direction func(t *model.Transaction) int
+
+// DefaultDirection credits deposits, rewards and refunds, debits withdrawals and slashing, and treats
+// staking operations, which move funds between balance categories, as neutral
+func DefaultDirection(t *model.Transaction) int {
+	switch t.Type {
+	case model.TransactionTypeDeposit, model.TransactionTypeInternalDeposit, model.TransactionTypeSweepDeposit,
+		model.TransactionTypeProxyDeposit, model.TransactionTypeDepositAdjustment, model.TransactionTypeReward,
+		model.TransactionTypeCoinbaseRefund, model.TransactionTypeCoinbaseDeposit:
+		return 1
+	case model.TransactionTypeWithdrawal, model.TransactionTypeInternalWithdrawal, model.TransactionTypeSweepWithdrawal,
+		model.TransactionTypeProxyWithdrawal, model.TransactionTypeBillingWithdrawal,
+		model.TransactionTypeWithdrawalAdjustment, model.TransactionTypeSlash:
+		return -1
+	}
+	return 0
+}
+
+// Config configures a Reconciler
+type Config struct {
+	// PortfolioId is the portfolio to reconcile (required)
+	PortfolioId string
+	// BalanceType is the ListPortfolioBalances type snapshotted (default model.BalanceTypeTotal)
+	BalanceType string
+	// Tolerance is the largest absolute unexplained difference that still reconciles (default zero)
+	Tolerance decimal.Decimal
+	// Lookback extends the transaction query before the start of the interval so that transactions
+	// created earlier but completed within it are found (default 24h)
+	Lookback time.Duration
+	// Direction overrides DefaultDirection
+	Direction Direction
+}
+
+// Reconciler snapshots balances and reconciles pairs of snapshots
+type Reconciler struct {
+	balances     balances.BalancesService
+	transactions transactions.TransactionsService
+	orders       orders.OrdersService
+	config       Config
+	now          func() time.Time
+}
+
+// New creates a Reconciler
+func New(
+	balancesService balances.BalancesService,
+	transactionsService transactions.TransactionsService,
+	ordersService orders.OrdersService,
+	config *Config,
+) (*Reconciler, error) {
+	if config == nil || len(config.PortfolioId) == 0 {
+		return nil, errors.New("reconcile portfolio id is required")
+	}
+
+	cfg := *config
+	if len(cfg.BalanceType) == 0 {
+		cfg.BalanceType = model.BalanceTypeTotal
+	}
+	if cfg.Lookback <= 0 {
+		cfg.Lookback = 24 * time.Hour
+	}
+	if cfg.Direction == nil {
+		cfg.Direction = DefaultDirection
+	}
+
+	return &Reconciler{
+		balances:     balancesService,
+		transactions: transactionsService,
+		orders:       ordersService,
+		config:       cfg,
+		now:          time.Now,
+	}, nil
+}
+
+// Snapshot lists the portfolio balances. Its time is taken before the request is sent.
+func (r *Reconciler) Snapshot(ctx context.Context) (*Snapshot, error) {
+	at := r.now()
+
+	resp, err := r.balances.ListPortfolioBalances(ctx, &balances.ListPortfolioBalancesRequest{
+		PortfolioId: r.config.PortfolioId,
+		Type:        r.config.BalanceType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list portfolio balances: %w", err)
+	}
+
+	snapshot := &Snapshot{
+		PortfolioId: r.config.PortfolioId,
+		BalanceType: r.config.BalanceType,
+		At:          at,
+		Balances:    make(map[string]decimal.Decimal, len(resp.Balances)),
+	}
+	for _, b := range resp.Balances {
+		amount, err := b.AmountNum()
+		if err != nil {
+			return nil, err
+		}
+		symbol := strings.ToUpper(b.Symbol)
+		snapshot.Balances[symbol] = snapshot.Balances[symbol].Add(amount)
+	}
+
+	return snapshot, nil
+}
+
+// Reconcile lists the transactions completed and fills executed between the snapshots and compares
+// the balance change they explain with the actual change of every symbol
+func (r *Reconciler) Reconcile(ctx context.Context, before, after *Snapshot) (*Report, error) {
+	if before == nil || after == nil {
+		return nil, errors.New("both snapshots are required")
+	}
+	if after.At.Before(before.At) {
+		return nil, errors.New("snapshots are out of order")
+	}
+
+	start, end := before.At, after.At
+	report := &Report{PortfolioId: r.config.PortfolioId, Start: start, End: end}
+
+	txResp, err := r.transactions.ListPortfolioTransactions(ctx, &transactions.ListPortfolioTransactionsRequest{
+		PortfolioId: r.config.PortfolioId,
+		Start:       start.Add(-r.config.Lookback),
+		End:         end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list portfolio transactions: %w", err)
+	}
+	txs, err := txResp.Iterator().WithConfig(nil).FetchAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list portfolio transactions: %w", err)
+	}
+
+	fillsResp, err := r.orders.ListPortfolioFills(ctx, &orders.ListPortfolioFillsRequest{
+		PortfolioId: r.config.PortfolioId,
+		Start:       start,
+		End:         end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list portfolio fills: %w", err)
+	}
+	fills, err := fillsResp.Iterator().WithConfig(nil).FetchAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list portfolio fills: %w", err)
+	}
+
+	pending := make(map[string][]string)
+	for _, t := range txs {
+		completed := t.Status == model.TransactionStatusDone || t.Status == model.TransactionStatusImported
+		if !completed {
+			if !t.Status.IsTerminal() && !t.Created.After(end) {
+				symbol := strings.ToUpper(t.Symbol)
+				pending[symbol] = append(pending[symbol], t.Id)
+			}
+			continue
+		}
+		if !within(t.Completed.Time, start, end) {
+			continue
+		}
+
+		movements, err := r.transactionMovements(t)
+		if err != nil {
+			return nil, err
+		}
+		report.Movements = append(report.Movements, movements...)
+	}
+
+	for _, f := range fills {
+		if !within(f.Time.Time, start, end) {
+			continue
+		}
+
+		movements, err := fillMovements(f)
+		if err != nil {
+			return nil, err
+		}
+		report.Movements = append(report.Movements, movements...)
+	}
+
+	symbols := make(map[string]*SymbolReconciliation)
+	symbol := func(s string) *SymbolReconciliation {
+		sr, ok := symbols[s]
+		if !ok {
+			sr = &SymbolReconciliation{
+				Symbol:                s,
+				Before:                before.Balances[s],
+				After:                 after.Balances[s],
+				TransactionIds:        []string{},
+				FillIds:               []string{},
+				PendingTransactionIds: []string{},
+			}
+			symbols[s] = sr
+		}
+		return sr
+	}
+
+	for s := range before.Balances {
+		symbol(s)
+	}
+	for s := range after.Balances {
+		symbol(s)
+	}
+	for _, m := range report.Movements {
+		sr := symbol(m.Symbol)
+		sr.Expected = sr.Expected.Add(m.Amount)
+		if len(m.TransactionId) > 0 && !slices.Contains(sr.TransactionIds, m.TransactionId) {
+			sr.TransactionIds = append(sr.TransactionIds, m.TransactionId)
+		}
+		if len(m.FillId) > 0 && !slices.Contains(sr.FillIds, m.FillId) {
+			sr.FillIds = append(sr.FillIds, m.FillId)
+		}
+	}
+
+	for _, sr := range symbols {
+		sr.Actual = sr.After.Sub(sr.Before)
+		sr.Unexplained = sr.Actual.Sub(sr.Expected)
+		sr.Reconciled = sr.Unexplained.Abs().LessThanOrEqual(r.config.Tolerance)
+		if ids, ok := pending[sr.Symbol]; ok {
+			sr.PendingTransactionIds = ids
+		}
+		report.Symbols = append(report.Symbols, sr)
+	}
+	sort.Slice(report.Symbols, func(i, j int) bool { return report.Symbols[i].Symbol < report.Symbols[j].Symbol })
+
+	return report, nil
+}
+
+// transactionMovements returns the balance changes of a completed transaction. Amounts are treated as
+// unsigned and fees are debited in FeeSymbol, or in the transaction symbol when it is empty.
+// Conversions debit the source symbol and credit DestinationSymbol with the same amount.
+func (r *Reconciler) transactionMovements(t *model.Transaction) ([]*Movement, error) {
+	amount, err := t.AmountNum()
+	if err != nil {
+		return nil, err
+	}
+	amount = amount.Abs()
+	symbol := strings.ToUpper(t.Symbol)
+
+	var movements []*Movement
+	if t.Type == model.TransactionTypeConversion && len(t.DestinationSymbol) > 0 {
+		movements = append(movements,
+			&Movement{Symbol: symbol, Amount: amount.Neg(), TransactionId: t.Id},
+			&Movement{Symbol: strings.ToUpper(t.DestinationSymbol), Amount: amount, TransactionId: t.Id},
+		)
+	} else if direction := r.config.Direction(t); direction != 0 {
+		movements = append(movements, &Movement{
+			Symbol:        symbol,
+			Amount:        amount.Mul(decimal.NewFromInt(int64(direction))),
+			TransactionId: t.Id,
+		})
+	}
+
+	fees, err := t.FeesNum()
+	if err != nil {
+		return nil, err
+	}
+	if !fees.IsZero() {
+		feeSymbol := strings.ToUpper(t.FeeSymbol)
+		if len(feeSymbol) == 0 {
+			feeSymbol = symbol
+		}
+		movements = append(movements, &Movement{Symbol: feeSymbol, Amount: fees.Abs().Neg(), TransactionId: t.Id})
+	}
+
+	return movements, nil
+}
+
+// fillMovements returns the base, quote and commission balance changes of a spot fill. Fills of
+// futures and of products that are not BASE-QUOTE do not move spot balances and are skipped.
+func fillMovements(f *model.OrderFill) ([]*Movement, error) {
+	if f.ProductType == model.ProductTypeFuture {
+		return nil, nil
+	}
+	base, quote, ok := strings.Cut(strings.ToUpper(f.ProductId), "-")
+	if !ok {
+		return nil, nil
+	}
+
+	quantity, err := f.FilledQuantityNum()
+	if err != nil {
+		return nil, err
+	}
+	value, err := f.FilledValueNum()
+	if err != nil {
+		return nil, err
+	}
+	if value.IsZero() {
+		price, err := f.PriceNum()
+		if err != nil {
+			return nil, err
+		}
+		value = quantity.Mul(price)
+	}
+	commission, err := f.CommissionNum()
+	if err != nil {
+		return nil, err
+	}
+
+	switch model.OrderSide(strings.ToUpper(f.Side)) {
+	case model.OrderSideBuy:
+		value = value.Neg()
+	case model.OrderSideSell:
+		quantity = quantity.Neg()
+	default:
+		return nil, fmt.Errorf("fill %s has unknown side: %s", f.Id, f.Side)
+	}
+
+	movements := []*Movement{
+		{Symbol: base, Amount: quantity, FillId: f.Id},
+		{Symbol: quote, Amount: value, FillId: f.Id},
+	}
+	if !commission.IsZero() {
+		movements = append(movements, &Movement{Symbol: quote, Amount: commission.Abs().Neg(), FillId: f.Id})
+	}
+
+	return movements, nil
+}
+
+// within returns true when t is after start and not after end
+func within(t, start, end time.Time) bool {
+	return t.After(start) && !t.After(end)
+}
@@ -0,0 +1,201 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/balances"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/coinbase-samples/prime-sdk-go/transactions"
+	"github.com/shopspring/decimal"
+)
+
+type fakeBalances struct {
+	balances.BalancesService
+	balances []*model.Balance
+}
+
+func (f *fakeBalances) ListPortfolioBalances(ctx context.Context, r *balances.ListPortfolioBalancesRequest) (*balances.ListPortfolioBalancesResponse, error) {
+	return &balances.ListPortfolioBalancesResponse{Balances: f.balances}, nil
+}
+
+type fakeTransactions struct {
+	transactions.TransactionsService
+	transactions []*model.Transaction
+	request      *transactions.ListPortfolioTransactionsRequest
+}
+
+func (f *fakeTransactions) ListPortfolioTransactions(ctx context.Context, r *transactions.ListPortfolioTransactionsRequest) (*transactions.ListPortfolioTransactionsResponse, error) {
+	f.request = r
+	return &transactions.ListPortfolioTransactionsResponse{Transactions: f.transactions, Request: r}, nil
+}
+
+type fakeOrders struct {
+	orders.OrdersService
+	fills []*model.OrderFill
+}
+
+func (f *fakeOrders) ListPortfolioFills(ctx context.Context, r *orders.ListPortfolioFillsRequest) (*orders.ListPortfolioFillsResponse, error) {
+	return &orders.ListPortfolioFillsResponse{Fills: f.fills, Request: r}, nil
+}
+
+func TestReconcile(t *testing.T) {
+	start := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	during := model.NewTimestamp(start.Add(30 * time.Minute))
+	earlier := model.NewTimestamp(start.Add(-time.Hour))
+
+	txs := &fakeTransactions{transactions: []*model.Transaction{
+		// Created before the interval and completed within it
+		{Id: "t1", Type: model.TransactionTypeDeposit, Status: model.TransactionStatusDone, Symbol: "USD", Amount: "5000", Created: earlier, Completed: during},
+		{Id: "t2", Type: model.TransactionTypeWithdrawal, Status: model.TransactionStatusDone, Symbol: "ETH", Amount: "-2", Fees: "0.01", FeeSymbol: "ETH", Created: during, Completed: during},
+		// Completed before the interval
+		{Id: "t3", Type: model.TransactionTypeDeposit, Status: model.TransactionStatusDone, Symbol: "BTC", Amount: "9", Created: earlier, Completed: earlier},
+		{Id: "t4", Type: model.TransactionTypeWithdrawal, Status: model.TransactionStatusProcessing, Symbol: "BTC", Amount: "0.1", Created: during},
+		{Id: "t5", Type: model.TransactionTypeDelegation, Status: model.TransactionStatusDone, Symbol: "ETH", Amount: "5", Created: during, Completed: during},
+		{Id: "t6", Type: model.TransactionTypeConversion, Status: model.TransactionStatusDone, Symbol: "USD", DestinationSymbol: "USDC", Amount: "1000", Created: during, Completed: during},
+	}}
+	ord := &fakeOrders{fills: []*model.OrderFill{
+		{Id: "f1", Side: "BUY", ProductId: "BTC-USD", FilledQuantity: "0.1", FilledValue: "3000", Commission: "3", Time: during},
+		{Id: "f2", Side: "SELL", ProductId: "ETH-USD", FilledQuantity: "1", Price: "2000", Commission: "2", Time: during},
+		{Id: "f3", Side: "BUY", ProductId: "BIT-27MAR26-CDE", FilledQuantity: "1", FilledValue: "1", ProductType: model.ProductTypeFuture, Time: during},
+	}}
+
+	r, err := New(&fakeBalances{}, txs, ord, &Config{PortfolioId: "p1", Tolerance: decimal.RequireFromString("0.00000001")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	before := &Snapshot{At: start, Balances: map[string]decimal.Decimal{
+		"USD": decimal.NewFromInt(10000),
+		"BTC": decimal.NewFromInt(1),
+		"ETH": decimal.NewFromInt(10),
+	}}
+	after := &Snapshot{At: end, Balances: map[string]decimal.Decimal{
+		// 10000 + 5000 - 1000 - 3000 - 3 + 2000 - 2
+		"USD":  decimal.NewFromInt(12995),
+		"USDC": decimal.NewFromInt(1000),
+		// 0.05 unexplained
+		"BTC": decimal.RequireFromString("1.15"),
+		// 10 - 2 - 0.01 - 1
+		"ETH": decimal.RequireFromString("6.99"),
+	}}
+
+	report, err := r.Reconcile(context.Background(), before, after)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !txs.request.Start.Equal(start.Add(-24 * time.Hour)) {
+		t.Errorf("expected transactions from a day before start, got %s", txs.request.Start)
+	}
+
+	cases := []struct {
+		symbol       string
+		expected     string
+		unexplained  string
+		transactions []string
+		fills        []string
+		pending      []string
+	}{
+		{symbol: "BTC", expected: "0.1", unexplained: "0.05", transactions: []string{}, fills: []string{"f1"}, pending: []string{"t4"}},
+		{symbol: "ETH", expected: "-3.01", unexplained: "0", transactions: []string{"t2"}, fills: []string{"f2"}, pending: []string{}},
+		{symbol: "USD", expected: "2995", unexplained: "0", transactions: []string{"t1", "t6"}, fills: []string{"f1", "f2"}, pending: []string{}},
+		{symbol: "USDC", expected: "1000", unexplained: "0", transactions: []string{"t6"}, fills: []string{}, pending: []string{}},
+	}
+
+	if len(report.Symbols) != len(cases) {
+		t.Fatalf("expected %d symbols, got %d", len(cases), len(report.Symbols))
+	}
+
+	for i, tt := range cases {
+		t.Run(tt.symbol, func(t *testing.T) {
+			sr := report.Symbols[i]
+			if sr.Symbol != tt.symbol {
+				t.Fatalf("expected symbol %s, got %s", tt.symbol, sr.Symbol)
+			}
+			if !sr.Expected.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("expected delta %s, got %s", tt.expected, sr.Expected)
+			}
+			if !sr.Unexplained.Equal(decimal.RequireFromString(tt.unexplained)) {
+				t.Errorf("expected unexplained %s, got %s", tt.unexplained, sr.Unexplained)
+			}
+			if sr.Reconciled != sr.Unexplained.IsZero() {
+				t.Errorf("expected reconciled %t", sr.Unexplained.IsZero())
+			}
+			assertIds(t, "transaction", tt.transactions, sr.TransactionIds)
+			assertIds(t, "fill", tt.fills, sr.FillIds)
+			assertIds(t, "pending", tt.pending, sr.PendingTransactionIds)
+		})
+	}
+
+	if report.Reconciled() {
+		t.Error("expected report not to reconcile")
+	}
+	if unexplained := report.Unexplained(); len(unexplained) != 1 || unexplained[0].Symbol != "BTC" {
+		t.Errorf("expected BTC unexplained, got %+v", unexplained)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	at := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	r, err := New(&fakeBalances{balances: []*model.Balance{
+		{Symbol: "btc", Amount: "1.5"},
+		{Symbol: "USD", Amount: ""},
+	}}, nil, nil, &Config{PortfolioId: "p1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r.now = func() time.Time { return at }
+
+	snapshot, err := r.Snapshot(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !snapshot.At.Equal(at) || snapshot.BalanceType != model.BalanceTypeTotal {
+		t.Errorf("unexpected snapshot: %+v", snapshot)
+	}
+	// An empty amount is a zero balance
+	if len(snapshot.Balances) != 2 || !snapshot.Balances["BTC"].Equal(decimal.RequireFromString("1.5")) || !snapshot.Balances["USD"].IsZero() {
+		t.Errorf("unexpected balances: %v", snapshot.Balances)
+	}
+}
+
+func TestNewRequiresPortfolio(t *testing.T) {
+	if _, err := New(nil, nil, nil, &Config{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func assertIds(t *testing.T, kind string, expected, got []string) {
+	t.Helper()
+	if len(expected) != len(got) {
+		t.Errorf("expected %s ids %v, got %v", kind, expected, got)
+		return
+	}
+	for i := range expected {
+		if expected[i] != got[i] {
+			t.Errorf("expected %s ids %v, got %v", kind, expected, got)
+			return
+		}
+	}
+}
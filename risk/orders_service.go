@@ -0,0 +1,433 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/shopspring/decimal"
+)
+
+// NewOrdersService wraps service with pre-trade checks on CreateOrder, EditOrder and AcceptQuote.
+// Read-only calls and CancelOrder pass through. Quotes are only accepted if they were requested
+// through the wrapper, since AcceptQuote does not carry the size and price needed for the checks.
+func NewOrdersService(service orders.OrdersService, config *Config) (orders.OrdersService, error) {
+	if service == nil {
+		return nil, errors.New("orders service not set")
+	}
+	if config == nil {
+		return nil, errors.New("risk config not set")
+	}
+	if len(config.Limits.MaxPosition) > 0 && config.Position == nil {
+		return nil, errors.New("position func is required with max position limits")
+	}
+
+	s := &ordersServiceImpl{
+		OrdersService: service,
+		config:        *config,
+		quotes:        make(map[string]trackedQuote),
+		now:           time.Now,
+	}
+	if s.config.Logger == nil {
+		s.config.Logger = slog.Default()
+	}
+	if s.config.QuoteTtl <= 0 {
+		s.config.QuoteTtl = DefaultQuoteTtl
+	}
+	if len(config.Limits.AllowedProducts) > 0 {
+		s.allowed = make(map[string]bool, len(config.Limits.AllowedProducts))
+		for _, id := range config.Limits.AllowedProducts {
+			s.allowed[id] = true
+		}
+	}
+	return s, nil
+}
+
+type ordersServiceImpl struct {
+	orders.OrdersService
+	config  Config
+	allowed map[string]bool
+	now     func() time.Time
+
+	mu     sync.Mutex
+	recent []time.Time
+	quotes map[string]trackedQuote
+}
+
+// trackedQuote is a quote requested through the wrapper, expressed as an order
+type trackedQuote struct {
+	order   *model.Order
+	expires time.Time
+}
+
+func (s *ordersServiceImpl) CreateOrder(ctx context.Context, request *orders.CreateOrderRequest) (*orders.CreateOrderResponse, error) {
+	if request.Order == nil {
+		return nil, errors.New("order not set on request")
+	}
+	if err := s.check(ctx, OperationCreateOrder, request.Order); err != nil {
+		return nil, err
+	}
+	return s.OrdersService.CreateOrder(ctx, request)
+}
+
+func (s *ordersServiceImpl) EditOrder(ctx context.Context, request *orders.EditOrderRequest) (*orders.EditOrderResponse, error) {
+	resp, err := s.OrdersService.GetOrder(ctx, &orders.GetOrderRequest{PortfolioId: request.PortfolioId, OrderId: request.OrderId})
+	if err != nil {
+		err = fmt.Errorf("unable to load order %s for risk check: %w", request.OrderId, err)
+		s.decide(Decision{Operation: OperationEditOrder, Err: err})
+		return nil, err
+	}
+	if resp.Order == nil {
+		err = fmt.Errorf("order %s not found for risk check", request.OrderId)
+		s.decide(Decision{Operation: OperationEditOrder, Err: err})
+		return nil, err
+	}
+
+	edited := *resp.Order
+	if len(request.LimitPrice) > 0 {
+		edited.LimitPrice = request.LimitPrice
+	}
+	if len(request.BaseQuantity) > 0 || len(request.QuoteValue) > 0 {
+		edited.BaseQuantity = request.BaseQuantity
+		edited.QuoteValue = request.QuoteValue
+	}
+
+	// Only the unfilled remainder of the edited order adds to the position
+	if len(edited.BaseQuantity) > 0 {
+		qty, err := edited.BaseQuantityNum()
+		if err != nil {
+			return nil, err
+		}
+		filled, err := edited.FilledQuantityNum()
+		if err != nil {
+			return nil, err
+		}
+		edited.BaseQuantity = decimal.Max(qty.Sub(filled), decimal.Zero).String()
+	}
+
+	if err := s.check(ctx, OperationEditOrder, &edited); err != nil {
+		return nil, err
+	}
+	return s.OrdersService.EditOrder(ctx, request)
+}
+
+func (s *ordersServiceImpl) CreateQuoteRequest(ctx context.Context, request *orders.CreateQuoteRequest) (*orders.CreateQuoteResponse, error) {
+	resp, err := s.OrdersService.CreateQuoteRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	expires, err := time.Parse(time.RFC3339Nano, resp.ExpirationTime)
+	if err != nil {
+		expires = now.Add(s.config.QuoteTtl)
+	}
+
+	s.mu.Lock()
+	s.evictQuotes(now)
+	s.quotes[resp.QuoteId] = trackedQuote{
+		order: &model.Order{
+			PortfolioId:  request.PortfolioId,
+			ProductId:    request.ProductId,
+			Side:         string(request.Side),
+			Type:         model.OrderTypeRfq,
+			BaseQuantity: request.BaseQuantity,
+			QuoteValue:   request.QuoteValue,
+			LimitPrice:   resp.BestPrice,
+		},
+		expires: expires,
+	}
+	s.mu.Unlock()
+
+	return resp, nil
+}
+
+func (s *ordersServiceImpl) AcceptQuote(ctx context.Context, request *orders.AcceptQuoteRequest) (*orders.AcceptQuoteResponse, error) {
+	s.mu.Lock()
+	s.evictQuotes(s.now())
+	quote, ok := s.quotes[request.QuoteId]
+	s.mu.Unlock()
+
+	if !ok {
+		v := &RiskViolation{
+			Type:        ViolationUnknownQuote,
+			Operation:   OperationAcceptQuote,
+			PortfolioId: request.PortfolioId,
+			ProductId:   request.ProductId,
+			Limit:       "requested through risk service",
+			Value:       request.QuoteId,
+		}
+		s.decide(Decision{Operation: OperationAcceptQuote, Violation: v})
+		return nil, v
+	}
+
+	if err := s.check(ctx, OperationAcceptQuote, quote.order); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.OrdersService.AcceptQuote(ctx, request)
+	if err == nil {
+		s.mu.Lock()
+		delete(s.quotes, request.QuoteId)
+		s.mu.Unlock()
+	}
+	return resp, err
+}
+
+// evictQuotes forgets quotes that expired by now. The caller holds s.mu.
+func (s *ordersServiceImpl) evictQuotes(now time.Time) {
+	for id, q := range s.quotes {
+		if !now.Before(q.expires) {
+			delete(s.quotes, id)
+		}
+	}
+}
+
+// check runs every configured control against order and reports the decision
+func (s *ordersServiceImpl) check(ctx context.Context, operation string, order *model.Order) error {
+	cp := *order
+	decision := Decision{Operation: operation, Order: &cp}
+
+	violation, err := s.evaluate(ctx, operation, &cp)
+	switch {
+	case err != nil:
+		decision.Err = err
+	case violation != nil:
+		decision.Violation = violation
+	default:
+		decision.Allowed = true
+	}
+	s.decide(decision)
+
+	if err != nil {
+		return err
+	}
+	if violation != nil {
+		return violation
+	}
+	return nil
+}
+
+func (s *ordersServiceImpl) evaluate(ctx context.Context, operation string, order *model.Order) (*RiskViolation, error) {
+	limits := &s.config.Limits
+
+	violation := func(t ViolationType, limit, value string) *RiskViolation {
+		return &RiskViolation{
+			Type:        t,
+			Operation:   operation,
+			PortfolioId: order.PortfolioId,
+			ProductId:   order.ProductId,
+			Limit:       limit,
+			Value:       value,
+		}
+	}
+
+	if s.allowed != nil && !s.allowed[order.ProductId] {
+		return violation(ViolationProduct, fmt.Sprint(limits.AllowedProducts), order.ProductId), nil
+	}
+
+	buy := order.Side == string(model.OrderSideBuy)
+	if !buy && order.Side != string(model.OrderSideSell) {
+		return nil, fmt.Errorf("invalid order side: %s", order.Side)
+	}
+
+	qty, err := order.BaseQuantityNum()
+	if err != nil {
+		return nil, err
+	}
+	value, err := order.QuoteValueNum()
+	if err != nil {
+		return nil, err
+	}
+	price, err := order.LimitPriceNum()
+	if err != nil {
+		return nil, err
+	}
+
+	_, positionLimited := limits.MaxPosition[order.ProductId]
+	needPrice := price.IsZero() && (limits.MaxOrderNotional.IsPositive() || positionLimited)
+	var preview *model.Order
+	if limits.PriceBand.IsPositive() || needPrice {
+		if preview, err = s.preview(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+
+	if limits.PriceBand.IsPositive() && price.IsPositive() {
+		v, err := priceBandViolation(buy, price, preview, limits.PriceBand)
+		if err != nil {
+			return nil, err
+		}
+		if len(v) > 0 {
+			return violation(ViolationPriceBand, v, price.String()), nil
+		}
+	}
+
+	if needPrice {
+		if price, err = preview.AverageFilledPriceNum(); err != nil {
+			return nil, err
+		}
+		// Without a price the notional and position limits cannot be checked, so fail closed
+		if !price.IsPositive() {
+			return nil, fmt.Errorf("no price to check %s order against limits", order.ProductId)
+		}
+	}
+
+	switch {
+	case value.IsZero() && price.IsPositive():
+		value = qty.Mul(price)
+	case qty.IsZero() && price.IsPositive():
+		qty = value.Div(price)
+	}
+
+	if limits.MaxOrderNotional.IsPositive() && value.GreaterThan(limits.MaxOrderNotional) {
+		return violation(ViolationNotional, limits.MaxOrderNotional.String(), value.String()), nil
+	}
+
+	if max, ok := limits.MaxPosition[order.ProductId]; ok {
+		position, err := s.config.Position(ctx, order.PortfolioId, order.ProductId)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load position for %s: %w", order.ProductId, err)
+		}
+		if !buy {
+			qty = qty.Neg()
+		}
+		if projected := position.Add(qty); projected.Abs().GreaterThan(max) {
+			return violation(ViolationPosition, max.String(), projected.String()), nil
+		}
+	}
+
+	if limits.MaxOrdersPerSecond > 0 {
+		if count, ok := s.allowRate(limits.MaxOrdersPerSecond); !ok {
+			return violation(ViolationOrderRate, fmt.Sprint(limits.MaxOrdersPerSecond), fmt.Sprint(count)), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// priceBandViolation returns the band edge the price is through, or an empty string. A preview
+// without a touch on the side the band is measured from is an error, since the band cannot be checked.
+func priceBandViolation(buy bool, price decimal.Decimal, preview *model.Order, band decimal.Decimal) (string, error) {
+	one := decimal.NewFromInt(1)
+	if buy {
+		ask, err := preview.BestAskNum()
+		if err != nil {
+			return "", err
+		}
+		if !ask.IsPositive() {
+			return "", errors.New("no best ask to check the price band against")
+		}
+		if edge := ask.Mul(one.Add(band)); price.GreaterThan(edge) {
+			return edge.String(), nil
+		}
+		return "", nil
+	}
+
+	bid, err := preview.BestBidNum()
+	if err != nil {
+		return "", err
+	}
+	if !bid.IsPositive() {
+		return "", errors.New("no best bid to check the price band against")
+	}
+	if edge := bid.Mul(one.Sub(band)); price.LessThan(edge) {
+		return edge.String(), nil
+	}
+	return "", nil
+}
+
+// preview fetches the touch and expected average price for a market order of the same size
+func (s *ordersServiceImpl) preview(ctx context.Context, order *model.Order) (*model.Order, error) {
+	resp, err := s.OrdersService.CreateOrderPreview(ctx, &orders.CreateOrderRequest{Order: &model.Order{
+		PortfolioId:  order.PortfolioId,
+		ProductId:    order.ProductId,
+		Side:         order.Side,
+		Type:         model.OrderTypeMarket,
+		BaseQuantity: order.BaseQuantity,
+		QuoteValue:   order.QuoteValue,
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("unable to preview order for risk check: %w", err)
+	}
+	if resp.Order == nil {
+		return nil, errors.New("order preview for risk check returned no order")
+	}
+	return resp.Order, nil
+}
+
+// allowRate records a request if fewer than max were allowed in the last second.
+// It returns the request rate including this request.
+func (s *ordersServiceImpl) allowRate(max int) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	cutoff := now.Add(-time.Second)
+	kept := s.recent[:0]
+	for _, t := range s.recent {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	s.recent = kept
+
+	if len(s.recent) >= max {
+		return len(s.recent) + 1, false
+	}
+	s.recent = append(s.recent, now)
+	return len(s.recent), true
+}
+
+func (s *ordersServiceImpl) decide(d Decision) {
+	d.Time = s.now()
+	logDecision(s.config.Logger, d)
+	if s.config.OnDecision != nil {
+		s.config.OnDecision(d)
+	}
+}
+
+func logDecision(logger *slog.Logger, d Decision) {
+	attrs := []any{slog.String("operation", d.Operation)}
+	if d.Order != nil {
+		attrs = append(attrs,
+			slog.String("portfolio_id", d.Order.PortfolioId),
+			slog.String("product_id", d.Order.ProductId),
+			slog.String("side", d.Order.Side),
+		)
+	}
+
+	switch {
+	case d.Err != nil:
+		logger.Error("risk check failed", append(attrs, slog.Any("error", d.Err))...)
+	case d.Violation != nil:
+		logger.Warn("risk check blocked request", append(attrs,
+			slog.String("violation", string(d.Violation.Type)),
+			slog.String("limit", d.Violation.Limit),
+			slog.String("value", d.Violation.Value),
+		)...)
+	default:
+		logger.Info("risk check allowed request", attrs...)
+	}
+}
@@ -0,0 +1,365 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package risk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/shopspring/decimal"
+)
+
+// fakeVenue counts mutating calls and previews a fixed touch
+type fakeVenue struct {
+	orders.OrdersService
+	created  int
+	edited   int
+	accepted int
+	existing *model.Order
+	// previewed overrides the previewed order when set; emptyPreview previews no order at all
+	previewed    *model.Order
+	emptyPreview bool
+}
+
+func (f *fakeVenue) CreateOrder(ctx context.Context, r *orders.CreateOrderRequest) (*orders.CreateOrderResponse, error) {
+	f.created++
+	return &orders.CreateOrderResponse{OrderId: "o1"}, nil
+}
+
+func (f *fakeVenue) EditOrder(ctx context.Context, r *orders.EditOrderRequest) (*orders.EditOrderResponse, error) {
+	f.edited++
+	return &orders.EditOrderResponse{OrderId: r.OrderId}, nil
+}
+
+func (f *fakeVenue) GetOrder(ctx context.Context, r *orders.GetOrderRequest) (*orders.GetOrderResponse, error) {
+	return &orders.GetOrderResponse{Order: f.existing}, nil
+}
+
+func (f *fakeVenue) CreateOrderPreview(ctx context.Context, r *orders.CreateOrderRequest) (*orders.CreateOrderPreviewResponse, error) {
+	if f.emptyPreview {
+		return &orders.CreateOrderPreviewResponse{}, nil
+	}
+	if f.previewed != nil {
+		return &orders.CreateOrderPreviewResponse{Order: f.previewed}, nil
+	}
+	return &orders.CreateOrderPreviewResponse{Order: &model.Order{BestBid: "99", BestAsk: "101", AverageFilledPrice: "101"}}, nil
+}
+
+func (f *fakeVenue) CreateQuoteRequest(ctx context.Context, r *orders.CreateQuoteRequest) (*orders.CreateQuoteResponse, error) {
+	return &orders.CreateQuoteResponse{QuoteId: "q1", BestPrice: "102"}, nil
+}
+
+func (f *fakeVenue) AcceptQuote(ctx context.Context, r *orders.AcceptQuoteRequest) (*orders.AcceptQuoteResponse, error) {
+	f.accepted++
+	return &orders.AcceptQuoteResponse{OrderId: "o2"}, nil
+}
+
+func newTestService(t *testing.T, venue *fakeVenue, config *Config) (orders.OrdersService, *[]Decision) {
+	t.Helper()
+	decisions := &[]Decision{}
+	config.OnDecision = func(d Decision) { *decisions = append(*decisions, d) }
+	if config.Logger == nil {
+		config.Logger = slog.New(slog.DiscardHandler)
+	}
+	svc, err := NewOrdersService(venue, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return svc, decisions
+}
+
+func violationType(err error) ViolationType {
+	var v *RiskViolation
+	if errors.As(err, &v) {
+		return v.Type
+	}
+	return ""
+}
+
+func buy(qty, price string) *orders.CreateOrderRequest {
+	return &orders.CreateOrderRequest{Order: &model.Order{
+		PortfolioId:  "p1",
+		ProductId:    "BTC-USD",
+		Side:         "BUY",
+		Type:         model.OrderTypeLimit,
+		BaseQuantity: qty,
+		LimitPrice:   price,
+	}}
+}
+
+func TestCreateOrderLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		limits  Limits
+		request *orders.CreateOrderRequest
+		want    ViolationType
+	}{
+		{
+			name:    "allowed",
+			limits:  Limits{MaxOrderNotional: decimal.NewFromInt(1000), PriceBand: decimal.RequireFromString("0.05")},
+			request: buy("5", "100"),
+		},
+		{
+			name:    "notional",
+			limits:  Limits{MaxOrderNotional: decimal.NewFromInt(1000)},
+			request: buy("11", "100"),
+			want:    ViolationNotional,
+		},
+		{
+			name:    "market notional uses preview price",
+			limits:  Limits{MaxOrderNotional: decimal.NewFromInt(1000)},
+			request: buy("10", ""),
+			want:    ViolationNotional,
+		},
+		{
+			name:    "product",
+			limits:  Limits{AllowedProducts: []string{"ETH-USD"}},
+			request: buy("1", "100"),
+			want:    ViolationProduct,
+		},
+		{
+			name:    "fat finger",
+			limits:  Limits{PriceBand: decimal.RequireFromString("0.05")},
+			request: buy("1", "1000"),
+			want:    ViolationPriceBand,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			venue := &fakeVenue{}
+			svc, decisions := newTestService(t, venue, &Config{Limits: tt.limits})
+
+			_, err := svc.CreateOrder(context.Background(), tt.request)
+			if got := violationType(err); got != tt.want {
+				t.Fatalf("expected violation %q, got %v", tt.want, err)
+			}
+
+			wantCreated := 1
+			if tt.want != "" {
+				wantCreated = 0
+			}
+			if venue.created != wantCreated {
+				t.Errorf("expected %d creates, got %d", wantCreated, venue.created)
+			}
+			if len(*decisions) != 1 || (*decisions)[0].Allowed != (tt.want == "") {
+				t.Errorf("unexpected decisions: %+v", *decisions)
+			}
+		})
+	}
+}
+
+func TestMaxPositionUsesCurrentPosition(t *testing.T) {
+	venue := &fakeVenue{}
+	svc, _ := newTestService(t, venue, &Config{
+		Limits: Limits{MaxPosition: map[string]decimal.Decimal{"BTC-USD": decimal.NewFromInt(10)}},
+		Position: func(ctx context.Context, portfolioId, productId string) (decimal.Decimal, error) {
+			return decimal.NewFromInt(8), nil
+		},
+	})
+
+	if _, err := svc.CreateOrder(context.Background(), buy("2", "100")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.CreateOrder(context.Background(), buy("3", "100")); violationType(err) != ViolationPosition {
+		t.Fatalf("expected position violation, got %v", err)
+	}
+}
+
+func TestMaxOrdersPerSecond(t *testing.T) {
+	venue := &fakeVenue{}
+	svc, _ := newTestService(t, venue, &Config{Limits: Limits{MaxOrdersPerSecond: 2}})
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc.(*ordersServiceImpl).now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if _, err := svc.CreateOrder(context.Background(), buy("1", "100")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := svc.CreateOrder(context.Background(), buy("1", "100")); violationType(err) != ViolationOrderRate {
+		t.Fatalf("expected rate violation, got %v", err)
+	}
+
+	now = now.Add(time.Second)
+	if _, err := svc.CreateOrder(context.Background(), buy("1", "100")); err != nil {
+		t.Fatalf("expected rate to recover, got %v", err)
+	}
+}
+
+func TestEditOrderChecksEditedOrder(t *testing.T) {
+	venue := &fakeVenue{existing: &model.Order{
+		Id:             "o1",
+		PortfolioId:    "p1",
+		ProductId:      "BTC-USD",
+		Side:           "SELL",
+		BaseQuantity:   "1",
+		LimitPrice:     "100",
+		FilledQuantity: "0",
+	}}
+	svc, _ := newTestService(t, venue, &Config{Limits: Limits{PriceBand: decimal.RequireFromString("0.05")}})
+
+	_, err := svc.EditOrder(context.Background(), &orders.EditOrderRequest{PortfolioId: "p1", OrderId: "o1", LimitPrice: "50"})
+	if violationType(err) != ViolationPriceBand || venue.edited != 0 {
+		t.Fatalf("expected price band violation, got %v", err)
+	}
+
+	if _, err := svc.EditOrder(context.Background(), &orders.EditOrderRequest{PortfolioId: "p1", OrderId: "o1", LimitPrice: "98"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestChecksFailClosedWithoutPreviewPrice(t *testing.T) {
+	cases := []struct {
+		description string
+		venue       *fakeVenue
+		limits      Limits
+		request     *orders.CreateOrderRequest
+	}{
+		{
+			description: "market order without average price",
+			venue:       &fakeVenue{previewed: &model.Order{BestBid: "99", BestAsk: "101"}},
+			limits:      Limits{MaxOrderNotional: decimal.NewFromInt(1000)},
+			request:     buy("1000", ""),
+		},
+		{
+			description: "position limit without average price",
+			venue:       &fakeVenue{previewed: &model.Order{}},
+			limits:      Limits{MaxPosition: map[string]decimal.Decimal{"BTC-USD": decimal.NewFromInt(1)}},
+			request:     &orders.CreateOrderRequest{Order: &model.Order{PortfolioId: "p1", ProductId: "BTC-USD", Side: "BUY", QuoteValue: "1000000"}},
+		},
+		{
+			description: "empty preview",
+			venue:       &fakeVenue{emptyPreview: true},
+			limits:      Limits{PriceBand: decimal.RequireFromString("0.05")},
+			request:     buy("1", "100"),
+		},
+		{
+			description: "preview without touch",
+			venue:       &fakeVenue{previewed: &model.Order{AverageFilledPrice: "100"}},
+			limits:      Limits{PriceBand: decimal.RequireFromString("0.05")},
+			request:     buy("1", "100"),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			config := &Config{Limits: tt.limits}
+			if len(tt.limits.MaxPosition) > 0 {
+				config.Position = func(ctx context.Context, portfolioId, productId string) (decimal.Decimal, error) {
+					return decimal.Zero, nil
+				}
+			}
+			svc, decisions := newTestService(t, tt.venue, config)
+
+			_, err := svc.CreateOrder(context.Background(), tt.request)
+			if err == nil || tt.venue.created != 0 {
+				t.Fatalf("expected the check to fail, got %v", err)
+			}
+			if len(*decisions) != 1 || (*decisions)[0].Err == nil {
+				t.Errorf("expected a failed decision, got %+v", *decisions)
+			}
+		})
+	}
+}
+
+func TestEditOrderMissingOrder(t *testing.T) {
+	venue := &fakeVenue{}
+	svc, decisions := newTestService(t, venue, &Config{})
+
+	_, err := svc.EditOrder(context.Background(), &orders.EditOrderRequest{PortfolioId: "p1", OrderId: "o1", LimitPrice: "98"})
+	if err == nil || venue.edited != 0 {
+		t.Fatalf("expected error for missing order, got %v", err)
+	}
+	if len(*decisions) != 1 || (*decisions)[0].Err == nil {
+		t.Fatalf("expected a failed decision, got %+v", *decisions)
+	}
+}
+
+func TestAcceptQuoteRequiresKnownQuote(t *testing.T) {
+	venue := &fakeVenue{}
+	svc, _ := newTestService(t, venue, &Config{Limits: Limits{MaxOrderNotional: decimal.NewFromInt(1000)}})
+	ctx := context.Background()
+
+	_, err := svc.AcceptQuote(ctx, &orders.AcceptQuoteRequest{PortfolioId: "p1", QuoteId: "q1"})
+	if violationType(err) != ViolationUnknownQuote {
+		t.Fatalf("expected unknown quote violation, got %v", err)
+	}
+
+	if _, err := svc.CreateQuoteRequest(ctx, &orders.CreateQuoteRequest{PortfolioId: "p1", ProductId: "BTC-USD", Side: model.OrderSideBuy, BaseQuantity: "20"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = svc.AcceptQuote(ctx, &orders.AcceptQuoteRequest{PortfolioId: "p1", QuoteId: "q1"})
+	if violationType(err) != ViolationNotional || venue.accepted != 0 {
+		t.Fatalf("expected notional violation, got %v", err)
+	}
+}
+
+func TestExpiredQuotesAreEvicted(t *testing.T) {
+	venue := &fakeVenue{}
+	svc, _ := newTestService(t, venue, &Config{QuoteTtl: time.Minute})
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	impl := svc.(*ordersServiceImpl)
+	impl.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, err := svc.CreateQuoteRequest(ctx, &orders.CreateQuoteRequest{PortfolioId: "p1", ProductId: "BTC-USD", Side: model.OrderSideBuy, BaseQuantity: "1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	_, err := svc.AcceptQuote(ctx, &orders.AcceptQuoteRequest{PortfolioId: "p1", QuoteId: "q1"})
+	if violationType(err) != ViolationUnknownQuote || venue.accepted != 0 {
+		t.Fatalf("expected unknown quote violation, got %v", err)
+	}
+	if len(impl.quotes) != 0 {
+		t.Fatalf("expected expired quote to be evicted, got %d quotes", len(impl.quotes))
+	}
+}
+
+func TestDecisionsAreLoggedWithoutOnDecision(t *testing.T) {
+	var buf bytes.Buffer
+	svc, err := NewOrdersService(&fakeVenue{}, &Config{
+		Limits: Limits{MaxOrderNotional: decimal.NewFromInt(1000)},
+		Logger: slog.New(slog.NewTextHandler(&buf, nil)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := svc.CreateOrder(context.Background(), buy("11", "100")); violationType(err) != ViolationNotional {
+		t.Fatalf("expected notional violation, got %v", err)
+	}
+	if !strings.Contains(buf.String(), "violation=MAX_ORDER_NOTIONAL") {
+		t.Fatalf("expected violation to be logged, got %q", buf.String())
+	}
+}
+
+func TestNewOrdersServiceRequiresPositionFunc(t *testing.T) {
+	_, err := NewOrdersService(&fakeVenue{}, &Config{Limits: Limits{MaxPosition: map[string]decimal.Decimal{"BTC-USD": decimal.NewFromInt(1)}}})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,112 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package risk provides client-side pre-trade controls. NewOrdersService wraps an orders.OrdersService
+// and checks every CreateOrder, EditOrder and AcceptQuote against configured Limits before it is sent.
+// NewWithdrawalGuard applies the same kind of checks to crypto withdrawals.
+package risk
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+// ViolationType identifies the limit a request breached
+type ViolationType string
+
+const (
+	ViolationNotional     ViolationType = "MAX_ORDER_NOTIONAL"
+	ViolationPosition     ViolationType = "MAX_POSITION"
+	ViolationOrderRate    ViolationType = "MAX_ORDERS_PER_SECOND"
+	ViolationProduct      ViolationType = "PRODUCT_NOT_ALLOWED"
+	ViolationPriceBand    ViolationType = "PRICE_BAND"
+	ViolationUnknownQuote ViolationType = "UNKNOWN_QUOTE"
+)
+
+// Operation names reported on decisions and violations
+const (
+	OperationCreateOrder = "CreateOrder"
+	OperationEditOrder   = "EditOrder"
+	OperationAcceptQuote = "AcceptQuote"
+)
+
+// RiskViolation is returned when a request breaches a limit. The request is not sent.
+type RiskViolation struct {
+	Type        ViolationType
+	Operation   string
+	PortfolioId string
+	ProductId   string
+	// Limit is the configured limit and Value the value that breached it
+	Limit string
+	Value string
+}
+
+func (e *RiskViolation) Error() string {
+	return fmt.Sprintf("risk violation %s on %s %s: %s exceeds limit %s", e.Type, e.Operation, e.ProductId, e.Value, e.Limit)
+}
+
+// Limits are the pre-trade controls. Zero values disable the corresponding check.
+type Limits struct {
+	// MaxOrderNotional caps the quote value of a single order
+	MaxOrderNotional decimal.Decimal
+	// MaxPosition caps the absolute base position per product id after the order fully fills
+	MaxPosition map[string]decimal.Decimal
+	// MaxOrdersPerSecond caps the rate of allowed requests across all operations
+	MaxOrdersPerSecond int
+	// AllowedProducts lists the product ids that may be traded (empty allows all)
+	AllowedProducts []string
+	// PriceBand is the fraction a limit or quote price may be through the touch from
+	// CreateOrderPreview, e.g. 0.05 rejects a buy priced more than 5% above BestAsk
+	PriceBand decimal.Decimal
+}
+
+// PositionFunc returns the current signed base position of a product in a portfolio
+type PositionFunc func(ctx context.Context, portfolioId, productId string) (decimal.Decimal, error)
+
+// Decision records the outcome of a pre-trade check
+type Decision struct {
+	Time      time.Time
+	Operation string
+	// Order is the order as checked. Edits and quotes are expressed as orders.
+	Order   *model.Order
+	Allowed bool
+	// Violation is set when a limit was breached
+	Violation *RiskViolation
+	// Err is set when the check itself failed, e.g. the preview or position lookup errored
+	Err error
+}
+
+// Config configures the risk controls
+type Config struct {
+	Limits Limits
+	// Position is required when Limits.MaxPosition is set
+	Position PositionFunc
+	// OnDecision is called synchronously for every check, allowed or not
+	OnDecision func(Decision)
+	// Logger logs every decision, allowed at info and blocked at warn or error (default slog.Default())
+	Logger *slog.Logger
+	// QuoteTtl is how long a requested quote can be accepted when its response has no parseable
+	// expiration time (default 1 minute). Expired quotes are forgotten.
+	QuoteTtl time.Duration
+}
+
+// DefaultQuoteTtl is used when Config.QuoteTtl is not set
+const DefaultQuoteTtl = time.Minute
@@ -0,0 +1,371 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/coinbase-samples/prime-sdk-go/financing"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/coinbase-samples/prime-sdk-go/utils"
+	"github.com/shopspring/decimal"
+)
+
+// SizingConstraint identifies what limits the size of an order
+type SizingConstraint string
+
+const (
+	ConstraintBuyingPower     SizingConstraint = "BUYING_POWER"
+	ConstraintWithdrawalPower SizingConstraint = "WITHDRAWAL_POWER"
+	ConstraintCreditFrozen    SizingConstraint = "CREDIT_FROZEN"
+	ConstraintBaseMaxSize     SizingConstraint = "BASE_MAX_SIZE"
+	ConstraintQuoteMaxSize    SizingConstraint = "QUOTE_MAX_SIZE"
+	ConstraintBaseMinSize     SizingConstraint = "BASE_MIN_SIZE"
+	ConstraintQuoteMinSize    SizingConstraint = "QUOTE_MIN_SIZE"
+)
+
+// SizingRequest describes the order to size
+type SizingRequest struct {
+	PortfolioId string
+	// Product supplies the size limits and increments. Its id (BASE-QUOTE) supplies the currencies.
+	Product *model.Product
+	Side    model.OrderSide
+	// Price converts quote amounts to base. It is required for buys and applies QuoteMinSize
+	// and QuoteMaxSize when set for sells.
+	Price decimal.Decimal
+	// CashOnly also caps the order by withdrawal power, so it only uses unencumbered funds
+	CashOnly bool
+	// NetOpenOrders subtracts the remaining size of open orders funded in the same currency from
+	// buying power and withdrawal power. Prime already nets holds for open orders out of both, so
+	// leave it unset unless the figures are known to be stale, or it double-counts the holds.
+	NetOpenOrders bool
+	// FeeRate is the expected commission rate. Buys pay the fee in the quote currency on top of
+	// the notional, so the quote amount is divided by Price * (1 + FeeRate). Sells pay it out of
+	// the proceeds and are not adjusted. Without it a maximum-size buy can be rejected for
+	// insufficient funds.
+	FeeRate decimal.Decimal
+}
+
+// SizingBound is the maximum base quantity allowed by one constraint
+type SizingBound struct {
+	Constraint SizingConstraint
+	// Currency and Available are the amount reported by Prime, when the constraint has one
+	Currency  string
+	Available decimal.Decimal
+	// MaxBaseQuantity is the largest base quantity the constraint allows, after reservations
+	MaxBaseQuantity decimal.Decimal
+}
+
+// SizingResult is the maximum executable size of an order
+type SizingResult struct {
+	Side model.OrderSide
+	// FundingCurrency is spent by the order: the quote currency for buys, the base for sells
+	FundingCurrency string
+	// Reserved is the FundingCurrency amount held by open orders, when NetOpenOrders is set
+	Reserved decimal.Decimal
+	// MaxBaseQuantity is rounded down to the base increment, or zero when below the product minimum
+	MaxBaseQuantity decimal.Decimal
+	// MaxQuoteValue is MaxBaseQuantity at Price, rounded down to the quote increment (zero without a price)
+	MaxQuoteValue decimal.Decimal
+	// BoundBy is the constraint that set MaxBaseQuantity
+	BoundBy SizingConstraint
+	Bounds  []*SizingBound
+	Credit  *model.PostTradeCreditInfo
+}
+
+// MaxOrderSize returns the largest order the portfolio can place for a side, considering buying
+// power, credit status, withdrawal power (CashOnly), the expected fee on buys (FeeRate), funds
+// reserved by open orders (NetOpenOrders) and product limits, and reports every bound along with
+// the one that bound the result.
+func MaxOrderSize(
+	ctx context.Context,
+	financingService financing.FinancingService,
+	ordersService orders.OrdersService,
+	request *SizingRequest,
+) (*SizingResult, error) {
+	product := request.Product
+	if product == nil {
+		return nil, errors.New("product is required")
+	}
+
+	baseCurrency, quoteCurrency, ok := strings.Cut(product.Id, "-")
+	if !ok {
+		return nil, fmt.Errorf("invalid product id: %s", product.Id)
+	}
+
+	result := &SizingResult{Side: request.Side}
+	switch request.Side {
+	case model.OrderSideBuy:
+		if !request.Price.IsPositive() {
+			return nil, errors.New("price is required to size a buy")
+		}
+		if request.FeeRate.IsNegative() {
+			return nil, errors.New("fee rate cannot be negative")
+		}
+		result.FundingCurrency = quoteCurrency
+	case model.OrderSideSell:
+		result.FundingCurrency = baseCurrency
+	default:
+		return nil, fmt.Errorf("invalid side: %s", request.Side)
+	}
+
+	// toBase converts an amount of the funding currency to base, leaving room for the fee on buys
+	toBase := func(amount decimal.Decimal) decimal.Decimal {
+		if request.Side == model.OrderSideBuy {
+			return amount.DivRound(request.Price.Mul(decimal.NewFromInt(1).Add(request.FeeRate)), 16)
+		}
+		return amount
+	}
+
+	if request.NetOpenOrders {
+		reserved, err := reservedFunds(ctx, ordersService, request.PortfolioId, result.FundingCurrency)
+		if err != nil {
+			return nil, err
+		}
+		result.Reserved = reserved
+	}
+
+	bp, err := financingService.GetBuyingPower(ctx, &financing.GetBuyingPowerRequest{
+		PortfolioId:   request.PortfolioId,
+		BaseCurrency:  baseCurrency,
+		QuoteCurrency: quoteCurrency,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get buying power: %w", err)
+	}
+	if bp.BuyingPower == nil {
+		return nil, errors.New("buying power not returned")
+	}
+
+	available, err := bp.BuyingPower.BaseBuyingPowerNum()
+	if request.Side == model.OrderSideBuy {
+		available, err = bp.BuyingPower.QuoteBuyingPowerNum()
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Bounds = append(result.Bounds, &SizingBound{
+		Constraint:      ConstraintBuyingPower,
+		Currency:        result.FundingCurrency,
+		Available:       available,
+		MaxBaseQuantity: toBase(available.Sub(result.Reserved)),
+	})
+
+	credit, err := financingService.GetPortfolioCreditInfo(ctx, &financing.GetPortfolioCreditInfoRequest{
+		PortfolioId:   request.PortfolioId,
+		BaseCurrency:  baseCurrency,
+		QuoteCurrency: quoteCurrency,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get portfolio credit info: %w", err)
+	}
+	result.Credit = credit.PortfolioCreditInfo
+	if result.Credit != nil && result.Credit.Frozen {
+		result.Bounds = append(result.Bounds, &SizingBound{Constraint: ConstraintCreditFrozen})
+	}
+
+	if request.CashOnly {
+		wp, err := financingService.GetWithdrawalPower(ctx, &financing.GetWithdrawalPowerRequest{
+			PortfolioId: request.PortfolioId,
+			Symbol:      result.FundingCurrency,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get withdrawal power: %w", err)
+		}
+		if wp.WithdrawalPower == nil {
+			return nil, errors.New("withdrawal power not returned")
+		}
+		amount, err := wp.WithdrawalPower.AmountNum()
+		if err != nil {
+			return nil, err
+		}
+		result.Bounds = append(result.Bounds, &SizingBound{
+			Constraint:      ConstraintWithdrawalPower,
+			Currency:        result.FundingCurrency,
+			Available:       amount,
+			MaxBaseQuantity: toBase(amount.Sub(result.Reserved)),
+		})
+	}
+
+	if err := productBounds(result, product, request.Price); err != nil {
+		return nil, err
+	}
+
+	for _, b := range result.Bounds {
+		if b.MaxBaseQuantity.IsNegative() {
+			b.MaxBaseQuantity = decimal.Zero
+		}
+		if len(result.BoundBy) == 0 || b.MaxBaseQuantity.LessThan(result.MaxBaseQuantity) {
+			result.MaxBaseQuantity = b.MaxBaseQuantity
+			result.BoundBy = b.Constraint
+		}
+	}
+
+	if err := applyMinimums(result, product, request.Price); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func productBounds(result *SizingResult, product *model.Product, price decimal.Decimal) error {
+	if len(product.BaseMaxSize) > 0 {
+		baseMax, err := product.BaseMaxSizeNum()
+		if err != nil {
+			return err
+		}
+		if baseMax.IsPositive() {
+			result.Bounds = append(result.Bounds, &SizingBound{Constraint: ConstraintBaseMaxSize, MaxBaseQuantity: baseMax})
+		}
+	}
+
+	if len(product.QuoteMaxSize) > 0 && price.IsPositive() {
+		quoteMax, err := product.QuoteMaxSizeNum()
+		if err != nil {
+			return err
+		}
+		if quoteMax.IsPositive() {
+			result.Bounds = append(result.Bounds, &SizingBound{Constraint: ConstraintQuoteMaxSize, MaxBaseQuantity: quoteMax.DivRound(price, 16)})
+		}
+	}
+
+	return nil
+}
+
+// applyMinimums rounds the result to the product increments and zeroes it when below a product
+// minimum. A result already bound to zero keeps its constraint.
+func applyMinimums(result *SizingResult, product *model.Product, price decimal.Decimal) error {
+	if !result.MaxBaseQuantity.IsPositive() {
+		result.MaxBaseQuantity = decimal.Zero
+		return nil
+	}
+
+	if len(product.BaseIncrement) > 0 {
+		inc, err := product.BaseIncrementNum()
+		if err != nil {
+			return err
+		}
+		result.MaxBaseQuantity = utils.RoundToIncrement(result.MaxBaseQuantity, inc, utils.RoundDown, "")
+	}
+
+	if len(product.BaseMinSize) > 0 {
+		baseMin, err := product.BaseMinSizeNum()
+		if err != nil {
+			return err
+		}
+		if result.MaxBaseQuantity.LessThan(baseMin) {
+			result.MaxBaseQuantity, result.BoundBy = decimal.Zero, ConstraintBaseMinSize
+		}
+	}
+
+	if !price.IsPositive() || result.MaxBaseQuantity.IsZero() {
+		return nil
+	}
+
+	value := result.MaxBaseQuantity.Mul(price)
+	if len(product.QuoteIncrement) > 0 {
+		inc, err := product.QuoteIncrementNum()
+		if err != nil {
+			return err
+		}
+		value = utils.RoundToIncrement(value, inc, utils.RoundDown, "")
+	}
+	result.MaxQuoteValue = value
+
+	if len(product.QuoteMinSize) > 0 {
+		quoteMin, err := product.QuoteMinSizeNum()
+		if err != nil {
+			return err
+		}
+		if value.LessThan(quoteMin) {
+			result.MaxBaseQuantity, result.MaxQuoteValue, result.BoundBy = decimal.Zero, decimal.Zero, ConstraintQuoteMinSize
+		}
+	}
+
+	return nil
+}
+
+// reservedFunds sums the unfilled remainder of open orders that spend currency: buys quoted in it
+// and sells of it. Buys sized in base are valued at their limit price; market orders without a
+// price are not counted.
+func reservedFunds(ctx context.Context, service orders.OrdersService, portfolioId, currency string) (decimal.Decimal, error) {
+	resp, err := service.ListOpenOrders(ctx, &orders.ListOpenOrdersRequest{PortfolioId: portfolioId})
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("unable to list open orders: %w", err)
+	}
+
+	reserved := decimal.Zero
+	for _, o := range resp.Orders {
+		base, quote, ok := strings.Cut(o.ProductId, "-")
+		if !ok {
+			continue
+		}
+
+		amount, err := remainingFunds(o, currency, base, quote)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("invalid open order %s: %w", o.Id, err)
+		}
+		reserved = reserved.Add(amount)
+	}
+
+	return reserved, nil
+}
+
+func remainingFunds(o *model.Order, currency, base, quote string) (decimal.Decimal, error) {
+	qty, err := o.BaseQuantityNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	filledQty, err := o.FilledQuantityNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	value, err := o.QuoteValueNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	filledValue, err := o.FilledValueNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	limit, err := o.LimitPriceNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	remaining := decimal.Zero
+	switch {
+	case o.Side == string(model.OrderSideBuy) && strings.EqualFold(quote, currency):
+		if value.IsPositive() {
+			remaining = value.Sub(filledValue)
+		} else {
+			remaining = qty.Sub(filledQty).Mul(limit)
+		}
+	case o.Side == string(model.OrderSideSell) && strings.EqualFold(base, currency):
+		if qty.IsPositive() {
+			remaining = qty.Sub(filledQty)
+		} else if limit.IsPositive() {
+			remaining = value.Sub(filledValue).DivRound(limit, 16)
+		}
+	}
+
+	return decimal.Max(remaining, decimal.Zero), nil
+}
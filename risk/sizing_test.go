@@ -0,0 +1,208 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package risk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coinbase-samples/prime-sdk-go/financing"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/orders"
+	"github.com/shopspring/decimal"
+)
+
+// fakeFinancing reports fixed buying power, withdrawal power and credit status
+type fakeFinancing struct {
+	financing.FinancingService
+	baseBuyingPower  string
+	quoteBuyingPower string
+	withdrawal       map[string]string
+	frozen           bool
+}
+
+func (f *fakeFinancing) GetBuyingPower(ctx context.Context, r *financing.GetBuyingPowerRequest) (*financing.GetBuyingPowerResponse, error) {
+	return &financing.GetBuyingPowerResponse{BuyingPower: &model.BuyingPower{
+		BaseCurrency:     r.BaseCurrency,
+		QuoteCurrency:    r.QuoteCurrency,
+		BaseBuyingPower:  f.baseBuyingPower,
+		QuoteBuyingPower: f.quoteBuyingPower,
+	}}, nil
+}
+
+func (f *fakeFinancing) GetWithdrawalPower(ctx context.Context, r *financing.GetWithdrawalPowerRequest) (*financing.GetWithdrawalPowerResponse, error) {
+	return &financing.GetWithdrawalPowerResponse{WithdrawalPower: &model.WithdrawalPower{Symbol: r.Symbol, Amount: f.withdrawal[r.Symbol]}}, nil
+}
+
+func (f *fakeFinancing) GetPortfolioCreditInfo(ctx context.Context, r *financing.GetPortfolioCreditInfoRequest) (*financing.GetPortfolioCreditInfoResponse, error) {
+	return &financing.GetPortfolioCreditInfoResponse{PortfolioCreditInfo: &model.PostTradeCreditInfo{Frozen: f.frozen}}, nil
+}
+
+type openOrders struct {
+	orders.OrdersService
+	open []*model.Order
+}
+
+func (o *openOrders) ListOpenOrders(ctx context.Context, r *orders.ListOpenOrdersRequest) (*orders.ListOpenOrdersResponse, error) {
+	return &orders.ListOpenOrdersResponse{Orders: o.open}, nil
+}
+
+func TestMaxOrderSize(t *testing.T) {
+	product := &model.Product{
+		Id:             "BTC-USD",
+		BaseIncrement:  "0.0001",
+		QuoteIncrement: "0.01",
+		BaseMinSize:    "0.001",
+		BaseMaxSize:    "50",
+		QuoteMinSize:   "1",
+		QuoteMaxSize:   "1000000",
+	}
+
+	open := []*model.Order{
+		{Id: "b1", ProductId: "BTC-USD", Side: "BUY", BaseQuantity: "1", FilledQuantity: "0.5", LimitPrice: "20000"},
+		{Id: "b2", ProductId: "ETH-USD", Side: "BUY", QuoteValue: "3000", FilledValue: "1000"},
+		{Id: "b3", ProductId: "ETH-BTC", Side: "BUY", BaseQuantity: "10", LimitPrice: "0.05"},
+		{Id: "s1", ProductId: "BTC-USD", Side: "SELL", BaseQuantity: "2", FilledQuantity: "0.5", LimitPrice: "40000"},
+	}
+
+	cases := []struct {
+		description string
+		venue       *fakeFinancing
+		request     SizingRequest
+		reserved    string
+		expectedQty string
+		expectedVal string
+		boundBy     SizingConstraint
+	}{
+		{
+			description: "buy bound by buying power",
+			venue:       &fakeFinancing{quoteBuyingPower: "100000"},
+			request:     SizingRequest{Side: model.OrderSideBuy, Price: decimal.NewFromInt(30000)},
+			reserved:    "0",
+			expectedQty: "3.3333",
+			expectedVal: "99999",
+			boundBy:     ConstraintBuyingPower,
+		},
+		{
+			description: "buy leaves room for the fee",
+			venue:       &fakeFinancing{quoteBuyingPower: "100000"},
+			request:     SizingRequest{Side: model.OrderSideBuy, Price: decimal.NewFromInt(30000), FeeRate: decimal.RequireFromString("0.0025")},
+			reserved:    "0",
+			expectedQty: "3.325",
+			expectedVal: "99750",
+			boundBy:     ConstraintBuyingPower,
+		},
+		{
+			description: "buy net of open buys",
+			venue:       &fakeFinancing{quoteBuyingPower: "100000"},
+			request:     SizingRequest{Side: model.OrderSideBuy, Price: decimal.NewFromInt(30000), NetOpenOrders: true},
+			reserved:    "12000",
+			expectedQty: "2.9333",
+			expectedVal: "87999",
+			boundBy:     ConstraintBuyingPower,
+		},
+		{
+			description: "cash only buy bound by withdrawal power",
+			venue:       &fakeFinancing{quoteBuyingPower: "100000", withdrawal: map[string]string{"USD": "42000"}},
+			request:     SizingRequest{Side: model.OrderSideBuy, Price: decimal.NewFromInt(30000), CashOnly: true},
+			reserved:    "0",
+			expectedQty: "1.4",
+			expectedVal: "42000",
+			boundBy:     ConstraintWithdrawalPower,
+		},
+		{
+			description: "cash only buy net of open buys",
+			venue:       &fakeFinancing{quoteBuyingPower: "100000", withdrawal: map[string]string{"USD": "42000"}},
+			request:     SizingRequest{Side: model.OrderSideBuy, Price: decimal.NewFromInt(30000), CashOnly: true, NetOpenOrders: true},
+			reserved:    "12000",
+			expectedQty: "1",
+			expectedVal: "30000",
+			boundBy:     ConstraintWithdrawalPower,
+		},
+		{
+			description: "sell bound by product max",
+			venue:       &fakeFinancing{baseBuyingPower: "500"},
+			request:     SizingRequest{Side: model.OrderSideSell, NetOpenOrders: true},
+			reserved:    "2",
+			expectedQty: "50",
+			expectedVal: "0",
+			boundBy:     ConstraintBaseMaxSize,
+		},
+		{
+			description: "sell net of open sells and buys quoted in base",
+			venue:       &fakeFinancing{baseBuyingPower: "2.5009"},
+			request:     SizingRequest{Side: model.OrderSideSell, Price: decimal.NewFromInt(30000), NetOpenOrders: true},
+			reserved:    "2",
+			expectedQty: "0.5009",
+			expectedVal: "15027",
+			boundBy:     ConstraintBuyingPower,
+		},
+		{
+			description: "reservations exceed buying power",
+			venue:       &fakeFinancing{baseBuyingPower: "2.0005"},
+			request:     SizingRequest{Side: model.OrderSideSell, NetOpenOrders: true},
+			reserved:    "2",
+			expectedQty: "0",
+			expectedVal: "0",
+			boundBy:     ConstraintBaseMinSize,
+		},
+		{
+			description: "frozen credit",
+			venue:       &fakeFinancing{quoteBuyingPower: "100000", frozen: true},
+			request:     SizingRequest{Side: model.OrderSideBuy, Price: decimal.NewFromInt(30000)},
+			reserved:    "0",
+			expectedQty: "0",
+			expectedVal: "0",
+			boundBy:     ConstraintCreditFrozen,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			request := tt.request
+			request.PortfolioId = "p1"
+			request.Product = product
+
+			result, err := MaxOrderSize(context.Background(), tt.venue, &openOrders{open: open}, &request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Reserved.Equal(decimal.RequireFromString(tt.reserved)) {
+				t.Errorf("expected reserved %s, got %s", tt.reserved, result.Reserved)
+			}
+			if !result.MaxBaseQuantity.Equal(decimal.RequireFromString(tt.expectedQty)) {
+				t.Errorf("expected quantity %s, got %s", tt.expectedQty, result.MaxBaseQuantity)
+			}
+			if !result.MaxQuoteValue.Equal(decimal.RequireFromString(tt.expectedVal)) {
+				t.Errorf("expected value %s, got %s", tt.expectedVal, result.MaxQuoteValue)
+			}
+			if result.BoundBy != tt.boundBy {
+				t.Errorf("expected bound by %s, got %s", tt.boundBy, result.BoundBy)
+			}
+		})
+	}
+}
+
+func TestMaxOrderSizeRequiresBuyPrice(t *testing.T) {
+	_, err := MaxOrderSize(context.Background(), &fakeFinancing{}, &openOrders{}, &SizingRequest{
+		Product: &model.Product{Id: "BTC-USD"},
+		Side:    model.OrderSideBuy,
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
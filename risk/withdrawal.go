@@ -0,0 +1,435 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/addressbook"
+	"github.com/coinbase-samples/prime-sdk-go/balances"
+	"github.com/coinbase-samples/prime-sdk-go/blockchain"
+	"github.com/coinbase-samples/prime-sdk-go/financing"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/onchainaddressbook"
+	"github.com/coinbase-samples/prime-sdk-go/transactions"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	ViolationDestination     ViolationType = "DESTINATION_NOT_ALLOWED"
+	ViolationDailyLimit      ViolationType = "DAILY_WITHDRAWAL_LIMIT"
+	ViolationWithdrawalPower ViolationType = "WITHDRAWAL_POWER"
+	ViolationWalletBalance   ViolationType = "WALLET_BALANCE"
+)
+
+// OperationCreateWalletWithdrawal is reported on withdrawal decisions and violations
+const OperationCreateWalletWithdrawal = "CreateWalletWithdrawal"
+
+const destinationBlockchain = "DESTINATION_BLOCKCHAIN"
+
+// withdrawalNamespace derives idempotency keys from business ids
+var withdrawalNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://github.com/coinbase-samples/prime-sdk-go/risk/withdrawal"))
+
+// WithdrawalIdempotencyKey returns the idempotency key SafeWithdrawal sends for a business id. The same
+// portfolio and business id always give the same key, so a retried withdrawal reuses it.
+func WithdrawalIdempotencyKey(portfolioId, businessId string) string {
+	return uuid.NewSHA1(withdrawalNamespace, []byte(portfolioId+"/"+businessId)).String()
+}
+
+// WithdrawalPolicy is the local withdrawal policy
+type WithdrawalPolicy struct {
+	// DailyLimits caps the amount withdrawn per symbol since midnight UTC, counting withdrawals listed by
+	// ListPortfolioTransactions that did not fail, except an earlier submission of the same business id.
+	// Symbols without a limit are not capped.
+	DailyLimits map[string]decimal.Decimal
+	// AllowedEntryStates restricts address book entries to these states (empty allows any state)
+	AllowedEntryStates []string
+	// AllowOnchainAddressGroups also accepts destinations listed in an onchain address book group
+	AllowOnchainAddressGroups bool
+}
+
+// WithdrawalServices are the services SafeWithdrawal reads from and submits to
+type WithdrawalServices struct {
+	Transactions transactions.TransactionsService
+	AddressBook  addressbook.AddressBookService
+	Financing    financing.FinancingService
+	Balances     balances.BalancesService
+	// OnchainAddressBook is required with WithdrawalPolicy.AllowOnchainAddressGroups
+	OnchainAddressBook onchainaddressbook.OnchainAddressBookService
+}
+
+// WithdrawalConfig configures a WithdrawalGuard
+type WithdrawalConfig struct {
+	Policy WithdrawalPolicy
+	// Audit records every decision before the withdrawal is submitted. When it returns an error the
+	// withdrawal is not submitted.
+	Audit func(ctx context.Context, decision *WithdrawalDecision) error
+}
+
+// SafeWithdrawalRequest is a crypto withdrawal to a blockchain address
+type SafeWithdrawalRequest struct {
+	// BusinessId identifies the withdrawal in the caller's system and derives the idempotency key (required)
+	BusinessId  string
+	PortfolioId string
+	WalletId    string
+	Symbol      string
+	Amount      decimal.Decimal
+	Destination *model.BlockchainAddress
+}
+
+// WithdrawalDecision is the auditable record of a withdrawal check
+type WithdrawalDecision struct {
+	Time           time.Time                `json:"time"`
+	BusinessId     string                   `json:"business_id"`
+	IdempotencyKey string                   `json:"idempotency_key"`
+	PortfolioId    string                   `json:"portfolio_id"`
+	WalletId       string                   `json:"wallet_id"`
+	Symbol         string                   `json:"symbol"`
+	Amount         decimal.Decimal          `json:"amount"`
+	Destination    *model.BlockchainAddress `json:"destination"`
+	// AddressBookEntryId or OnchainAddressGroupId is the allowlist entry that matched the destination
+	AddressBookEntryId    string          `json:"address_book_entry_id,omitempty"`
+	OnchainAddressGroupId string          `json:"onchain_address_group_id,omitempty"`
+	WithdrawalPower       decimal.Decimal `json:"withdrawal_power"`
+	WalletBalance         decimal.Decimal `json:"wallet_balance"`
+	WithdrawnToday        decimal.Decimal `json:"withdrawn_today"`
+	// DailyLimit is zero when the symbol has no limit
+	DailyLimit decimal.Decimal `json:"daily_limit"`
+	Allowed    bool            `json:"allowed"`
+	// Violation is set when a check failed
+	Violation *RiskViolation `json:"violation,omitempty"`
+	// Err is set when a check could not be made, e.g. a lookup errored
+	Err error `json:"-"`
+	// Response is set once the withdrawal was submitted
+	Response *transactions.CreateWalletWithdrawalResponse `json:"response,omitempty"`
+}
+
+// WithdrawalGuard checks withdrawals against the address book allowlist, withdrawal power, wallet
+// balance and daily limits before submitting them. Withdrawals of the same portfolio and symbol are
+// checked and submitted one at a time, so concurrent calls cannot each pass the same daily limit.
+type WithdrawalGuard struct {
+	services WithdrawalServices
+	config   WithdrawalConfig
+	now      func() time.Time
+
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// NewWithdrawalGuard creates a WithdrawalGuard
+func NewWithdrawalGuard(services *WithdrawalServices, config *WithdrawalConfig) (*WithdrawalGuard, error) {
+	if services == nil || services.Transactions == nil || services.AddressBook == nil ||
+		services.Financing == nil || services.Balances == nil {
+		return nil, errors.New("transactions, address book, financing and balances services are required")
+	}
+	if config == nil {
+		return nil, errors.New("withdrawal config not set")
+	}
+	if config.Policy.AllowOnchainAddressGroups && services.OnchainAddressBook == nil {
+		return nil, errors.New("onchain address book service is required to allow onchain address groups")
+	}
+
+	return &WithdrawalGuard{
+		services: *services,
+		config:   *config,
+		now:      time.Now,
+		locks:    make(map[string]*sync.Mutex),
+	}, nil
+}
+
+// lock serializes withdrawals of a symbol from a portfolio and returns the unlock func
+func (g *WithdrawalGuard) lock(portfolioId, symbol string) func() {
+	key := portfolioId + "/" + symbol
+	g.mu.Lock()
+	l, ok := g.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		g.locks[key] = l
+	}
+	g.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// SafeWithdrawal checks the withdrawal, passes the decision to Audit and, if it is allowed and audited,
+// submits it with CreateWalletWithdrawal under an idempotency key derived from the business id.
+// It returns the decision with a *RiskViolation error when a check fails.
+func (g *WithdrawalGuard) SafeWithdrawal(ctx context.Context, request *SafeWithdrawalRequest) (*WithdrawalDecision, error) {
+	if len(request.BusinessId) == 0 {
+		return nil, errors.New("business id is required")
+	}
+	if request.Destination == nil || len(request.Destination.Address) == 0 {
+		return nil, errors.New("destination address is required")
+	}
+	if !request.Amount.IsPositive() {
+		return nil, errors.New("amount must be greater than zero")
+	}
+
+	symbol := strings.ToUpper(request.Symbol)
+	defer g.lock(request.PortfolioId, symbol)()
+
+	decision := &WithdrawalDecision{
+		Time:           g.now(),
+		BusinessId:     request.BusinessId,
+		IdempotencyKey: WithdrawalIdempotencyKey(request.PortfolioId, request.BusinessId),
+		PortfolioId:    request.PortfolioId,
+		WalletId:       request.WalletId,
+		Symbol:         symbol,
+		Amount:         request.Amount,
+		Destination:    request.Destination,
+	}
+
+	violation, err := g.evaluate(ctx, decision)
+	switch {
+	case err != nil:
+		decision.Err = err
+	case violation != nil:
+		decision.Violation = violation
+	default:
+		decision.Allowed = true
+	}
+
+	if g.config.Audit != nil {
+		if auditErr := g.config.Audit(ctx, decision); auditErr != nil {
+			return decision, fmt.Errorf("unable to audit withdrawal %s: %w", request.BusinessId, auditErr)
+		}
+	}
+	if err != nil {
+		return decision, err
+	}
+	if violation != nil {
+		return decision, violation
+	}
+
+	resp, err := g.services.Transactions.CreateWalletWithdrawal(ctx, &transactions.CreateWalletWithdrawalRequest{
+		PortfolioId:       request.PortfolioId,
+		SourceWalletId:    request.WalletId,
+		Amount:            request.Amount.String(),
+		DestinationType:   destinationBlockchain,
+		IdempotencyKey:    decision.IdempotencyKey,
+		Symbol:            decision.Symbol,
+		BlockchainAddress: request.Destination,
+	})
+	if err != nil {
+		return decision, err
+	}
+	decision.Response = resp
+
+	return decision, nil
+}
+
+// evaluate fills in the decision and returns the first failed check: destination, daily limit,
+// withdrawal power, then wallet balance
+func (g *WithdrawalGuard) evaluate(ctx context.Context, d *WithdrawalDecision) (*RiskViolation, error) {
+	violation := func(t ViolationType, limit, value string) *RiskViolation {
+		return &RiskViolation{
+			Type:        t,
+			Operation:   OperationCreateWalletWithdrawal,
+			PortfolioId: d.PortfolioId,
+			ProductId:   d.Symbol,
+			Limit:       limit,
+			Value:       value,
+		}
+	}
+
+	allowed, err := g.matchDestination(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return violation(ViolationDestination, "address book", d.Destination.Address), nil
+	}
+
+	if limit, ok := g.config.Policy.DailyLimits[d.Symbol]; ok {
+		d.DailyLimit = limit
+		if d.WithdrawnToday, err = g.withdrawnToday(ctx, d); err != nil {
+			return nil, err
+		}
+		if total := d.WithdrawnToday.Add(d.Amount); total.GreaterThan(limit) {
+			return violation(ViolationDailyLimit, limit.String(), total.String()), nil
+		}
+	}
+
+	powerResp, err := g.services.Financing.GetWithdrawalPower(ctx, &financing.GetWithdrawalPowerRequest{
+		PortfolioId: d.PortfolioId,
+		Symbol:      d.Symbol,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get withdrawal power: %w", err)
+	}
+	if powerResp.WithdrawalPower != nil {
+		if d.WithdrawalPower, err = powerResp.WithdrawalPower.AmountNum(); err != nil {
+			return nil, err
+		}
+	}
+	if d.Amount.GreaterThan(d.WithdrawalPower) {
+		return violation(ViolationWithdrawalPower, d.WithdrawalPower.String(), d.Amount.String()), nil
+	}
+
+	balanceResp, err := g.services.Balances.GetWalletBalance(ctx, &balances.GetWalletBalanceRequest{
+		PortfolioId: d.PortfolioId,
+		Id:          d.WalletId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get wallet balance: %w", err)
+	}
+	if d.WalletBalance, err = withdrawable(balanceResp.Balance); err != nil {
+		return nil, err
+	}
+	if d.Amount.GreaterThan(d.WalletBalance) {
+		return violation(ViolationWalletBalance, d.WalletBalance.String(), d.Amount.String()), nil
+	}
+
+	return nil, nil
+}
+
+// matchDestination looks the destination up in the address book for the symbol and, when allowed,
+// in the onchain address book groups of the destination's network family, recording the entry that
+// matched
+func (g *WithdrawalGuard) matchDestination(ctx context.Context, d *WithdrawalDecision) (bool, error) {
+	resp, err := g.services.AddressBook.GetAddressBook(ctx, &addressbook.GetAddressBookRequest{
+		PortfolioId: d.PortfolioId,
+		Symbol:      d.Symbol,
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to get address book: %w", err)
+	}
+	entries, err := resp.Iterator().WithConfig(nil).FetchAll(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unable to get address book: %w", err)
+	}
+
+	states := g.config.Policy.AllowedEntryStates
+	for _, e := range entries {
+		if e.Type == model.AddressBookTypeCounterpartyId || !strings.EqualFold(e.Symbol, d.Symbol) {
+			continue
+		}
+		if len(states) > 0 && !slices.Contains(states, e.State) {
+			continue
+		}
+		if sameAddress(e.Address, d.Destination.Address) && e.AccountIdentifier == d.Destination.AccountIdentifier {
+			d.AddressBookEntryId = e.Id
+			return true, nil
+		}
+	}
+
+	if !g.config.Policy.AllowOnchainAddressGroups || len(d.Destination.AccountIdentifier) > 0 {
+		return false, nil
+	}
+
+	// Groups only cover one network type, so an unknown network cannot match any of them
+	network, ok := blockchain.Lookup(d.Destination.Network, d.Symbol)
+	if !ok {
+		return false, nil
+	}
+
+	groupsResp, err := g.services.OnchainAddressBook.ListOnchainAddressBookGroups(ctx, &onchainaddressbook.ListOnchainAddressBookGroupsRequest{
+		PortfolioId: d.PortfolioId,
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to list onchain address groups: %w", err)
+	}
+	for _, group := range groupsResp.AddressGroups {
+		if family, ok := blockchain.OnchainFamily(group.NetworkType); !ok || family != network.Family {
+			continue
+		}
+		for _, a := range group.Addresses {
+			if sameAddress(a.Address, d.Destination.Address) {
+				d.OnchainAddressGroupId = group.Id
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// withdrawnToday sums the withdrawals of the symbol created since midnight UTC that did not fail. A
+// withdrawal submitted under the decision's idempotency key is a retry of this one and is not counted.
+func (g *WithdrawalGuard) withdrawnToday(ctx context.Context, d *WithdrawalDecision) (decimal.Decimal, error) {
+	now := d.Time.UTC()
+	resp, err := g.services.Transactions.ListPortfolioTransactions(ctx, &transactions.ListPortfolioTransactionsRequest{
+		PortfolioId: d.PortfolioId,
+		Symbols:     d.Symbol,
+		Types:       []model.TransactionType{model.TransactionTypeWithdrawal},
+		Start:       time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		End:         now,
+	})
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("unable to list withdrawals: %w", err)
+	}
+	txs, err := resp.Iterator().WithConfig(nil).FetchAll(ctx)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("unable to list withdrawals: %w", err)
+	}
+
+	total := decimal.Zero
+	for _, t := range txs {
+		switch t.Status {
+		case model.TransactionStatusCancelled, model.TransactionStatusRejected,
+			model.TransactionStatusFailed, model.TransactionStatusExpired:
+			continue
+		}
+		if !strings.EqualFold(t.Symbol, d.Symbol) || t.IdempotencyKey == d.IdempotencyKey {
+			continue
+		}
+		amount, err := t.AmountNum()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		total = total.Add(amount.Abs())
+	}
+	return total, nil
+}
+
+// withdrawable returns the withdrawable amount of a wallet balance, or its amount less holds when the
+// withdrawable amount is not reported
+func withdrawable(b *model.Balance) (decimal.Decimal, error) {
+	if b == nil {
+		return decimal.Zero, nil
+	}
+	if len(b.WithdrawableAmount) > 0 {
+		return b.WithdrawableAmountNum()
+	}
+
+	amount, err := b.AmountNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	holds, err := b.HoldsNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return amount.Sub(holds), nil
+}
+
+// sameAddress compares addresses exactly, except EVM hex addresses which compare case-insensitively
+// since their case only carries a checksum
+func sameAddress(a, b string) bool {
+	if strings.HasPrefix(a, "0x") && strings.HasPrefix(b, "0x") {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
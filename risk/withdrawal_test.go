@@ -0,0 +1,364 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/addressbook"
+	"github.com/coinbase-samples/prime-sdk-go/balances"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/onchainaddressbook"
+	"github.com/coinbase-samples/prime-sdk-go/transactions"
+	"github.com/shopspring/decimal"
+)
+
+type fakeWithdrawals struct {
+	transactions.TransactionsService
+	today     []*model.Transaction
+	submitted []*transactions.CreateWalletWithdrawalRequest
+	// recordSubmitted lists submitted withdrawals as today's transactions
+	recordSubmitted bool
+	// listDelay holds each listing open so concurrent checks overlap
+	listDelay time.Duration
+}
+
+func (f *fakeWithdrawals) ListPortfolioTransactions(ctx context.Context, r *transactions.ListPortfolioTransactionsRequest) (*transactions.ListPortfolioTransactionsResponse, error) {
+	today := f.today
+	time.Sleep(f.listDelay)
+	return &transactions.ListPortfolioTransactionsResponse{Transactions: today, Request: r}, nil
+}
+
+func (f *fakeWithdrawals) CreateWalletWithdrawal(ctx context.Context, r *transactions.CreateWalletWithdrawalRequest) (*transactions.CreateWalletWithdrawalResponse, error) {
+	f.submitted = append(f.submitted, r)
+	if f.recordSubmitted {
+		f.today = append(f.today, &model.Transaction{
+			Id:             r.IdempotencyKey,
+			Symbol:         r.Symbol,
+			Status:         model.TransactionStatusCreated,
+			Amount:         r.Amount,
+			IdempotencyKey: r.IdempotencyKey,
+		})
+	}
+	return &transactions.CreateWalletWithdrawalResponse{TransactionId: "t-new", Request: r}, nil
+}
+
+type fakeAddressBook struct {
+	addressbook.AddressBookService
+	entries []*model.AddressBookEntry
+}
+
+func (f *fakeAddressBook) GetAddressBook(ctx context.Context, r *addressbook.GetAddressBookRequest) (*addressbook.GetAddressBookResponse, error) {
+	return &addressbook.GetAddressBookResponse{Addresses: f.entries, Request: r}, nil
+}
+
+type fakeOnchainAddressBook struct {
+	onchainaddressbook.OnchainAddressBookService
+	groups []*model.OnchainAddressGroup
+}
+
+func (f *fakeOnchainAddressBook) ListOnchainAddressBookGroups(ctx context.Context, r *onchainaddressbook.ListOnchainAddressBookGroupsRequest) (*onchainaddressbook.ListOnchainAddressBookGroupsResponse, error) {
+	return &onchainaddressbook.ListOnchainAddressBookGroupsResponse{AddressGroups: f.groups}, nil
+}
+
+type fakeWalletBalance struct {
+	balances.BalancesService
+	balance *model.Balance
+}
+
+func (f *fakeWalletBalance) GetWalletBalance(ctx context.Context, r *balances.GetWalletBalanceRequest) (*balances.GetWalletBalanceResponse, error) {
+	return &balances.GetWalletBalanceResponse{Balance: f.balance}, nil
+}
+
+func TestSafeWithdrawal(t *testing.T) {
+	book := []*model.AddressBookEntry{
+		{Id: "e1", Symbol: "ETH", Address: "0x52908400098527886E0F7030069857D2E4169EE7", State: "ACTIVE"},
+		{Id: "e2", Symbol: "ETH", Address: "0x8617E340B3D01FA5F11F306F4090FD50E238070D", State: "PENDING"},
+		{Id: "e3", Symbol: "XRP", Address: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", AccountIdentifier: "123", State: "ACTIVE"},
+	}
+	groups := []*model.OnchainAddressGroup{
+		{Id: "g1", NetworkType: model.OnchainNetworkTypeEvm, Addresses: []*model.OnchainAddress{{Address: "0xde709f2102306220921060314715629080e2fb77"}}},
+		{Id: "g2", NetworkType: model.OnchainNetworkTypeSolana, Addresses: []*model.OnchainAddress{{Address: "0x281055afc982d96fab65b3a49cac8b878184cb16"}}},
+	}
+	today := []*model.Transaction{
+		{Id: "w1", Symbol: "ETH", Type: model.TransactionTypeWithdrawal, Status: model.TransactionStatusDone, Amount: "-6"},
+		{Id: "w2", Symbol: "ETH", Type: model.TransactionTypeWithdrawal, Status: model.TransactionStatusFailed, Amount: "50"},
+	}
+
+	cases := []struct {
+		description string
+		symbol      string
+		amount      string
+		destination *model.BlockchainAddress
+		power       string
+		balance     *model.Balance
+		violation   ViolationType
+		entryId     string
+		groupId     string
+	}{
+		{
+			description: "allowed, checksum case ignored",
+			symbol:      "eth",
+			amount:      "3",
+			destination: &model.BlockchainAddress{Address: "0x52908400098527886e0f7030069857d2e4169ee7"},
+			power:       "100",
+			balance:     &model.Balance{Amount: "20", Holds: "1"},
+			entryId:     "e1",
+		},
+		{
+			description: "entry in a state that is not allowed",
+			symbol:      "ETH",
+			amount:      "1",
+			destination: &model.BlockchainAddress{Address: "0x8617E340B3D01FA5F11F306F4090FD50E238070D"},
+			violation:   ViolationDestination,
+		},
+		{
+			description: "onchain address group",
+			symbol:      "ETH",
+			amount:      "1",
+			destination: &model.BlockchainAddress{Address: "0xDE709F2102306220921060314715629080E2FB77"},
+			power:       "100",
+			balance:     &model.Balance{WithdrawableAmount: "5"},
+			groupId:     "g1",
+		},
+		{
+			description: "onchain address group of another network",
+			symbol:      "ETH",
+			amount:      "1",
+			destination: &model.BlockchainAddress{Address: "0x281055afc982d96fab65b3a49cac8b878184cb16"},
+			violation:   ViolationDestination,
+		},
+		{
+			description: "memo must match",
+			symbol:      "XRP",
+			amount:      "1",
+			destination: &model.BlockchainAddress{Address: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", AccountIdentifier: "999"},
+			violation:   ViolationDestination,
+		},
+		{
+			description: "daily limit counts withdrawals that did not fail",
+			symbol:      "ETH",
+			amount:      "5",
+			destination: &model.BlockchainAddress{Address: "0x52908400098527886E0F7030069857D2E4169EE7"},
+			violation:   ViolationDailyLimit,
+		},
+		{
+			description: "withdrawal power",
+			symbol:      "ETH",
+			amount:      "2",
+			destination: &model.BlockchainAddress{Address: "0x52908400098527886E0F7030069857D2E4169EE7"},
+			power:       "1.5",
+			violation:   ViolationWithdrawalPower,
+		},
+		{
+			description: "wallet balance net of holds",
+			symbol:      "ETH",
+			amount:      "2",
+			destination: &model.BlockchainAddress{Address: "0x52908400098527886E0F7030069857D2E4169EE7"},
+			power:       "100",
+			balance:     &model.Balance{Amount: "2", Holds: "0.5"},
+			violation:   ViolationWalletBalance,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			txs := &fakeWithdrawals{today: today}
+			var audited []*WithdrawalDecision
+
+			guard, err := NewWithdrawalGuard(&WithdrawalServices{
+				Transactions:       txs,
+				AddressBook:        &fakeAddressBook{entries: book},
+				Financing:          &fakeFinancing{withdrawal: map[string]string{tt.symbol: tt.power, "ETH": tt.power}},
+				Balances:           &fakeWalletBalance{balance: tt.balance},
+				OnchainAddressBook: &fakeOnchainAddressBook{groups: groups},
+			}, &WithdrawalConfig{
+				Policy: WithdrawalPolicy{
+					DailyLimits:               map[string]decimal.Decimal{"ETH": decimal.NewFromInt(10)},
+					AllowedEntryStates:        []string{"ACTIVE"},
+					AllowOnchainAddressGroups: true,
+				},
+				Audit: func(ctx context.Context, d *WithdrawalDecision) error {
+					audited = append(audited, d)
+					return nil
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			decision, err := guard.SafeWithdrawal(context.Background(), &SafeWithdrawalRequest{
+				BusinessId:  "payout-42",
+				PortfolioId: "p1",
+				WalletId:    "w1",
+				Symbol:      tt.symbol,
+				Amount:      decimal.RequireFromString(tt.amount),
+				Destination: tt.destination,
+			})
+
+			if len(audited) != 1 || audited[0] != decision {
+				t.Fatalf("expected the decision to be audited once, got %d", len(audited))
+			}
+
+			if len(tt.violation) > 0 {
+				var violation *RiskViolation
+				if !errors.As(err, &violation) || violation.Type != tt.violation {
+					t.Fatalf("expected %s violation, got %v", tt.violation, err)
+				}
+				if decision.Allowed || len(txs.submitted) != 0 {
+					t.Errorf("expected the withdrawal to be blocked")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !decision.Allowed || decision.Response == nil || len(txs.submitted) != 1 {
+				t.Fatalf("expected the withdrawal to be submitted: %+v", decision)
+			}
+			if decision.AddressBookEntryId != tt.entryId || decision.OnchainAddressGroupId != tt.groupId {
+				t.Errorf("unexpected match: entry %q group %q", decision.AddressBookEntryId, decision.OnchainAddressGroupId)
+			}
+			if !decision.WithdrawnToday.Equal(decimal.NewFromInt(6)) {
+				t.Errorf("expected 6 withdrawn today, got %s", decision.WithdrawnToday)
+			}
+
+			submitted := txs.submitted[0]
+			if submitted.IdempotencyKey != WithdrawalIdempotencyKey("p1", "payout-42") || submitted.Symbol != "ETH" ||
+				submitted.Amount != tt.amount || submitted.DestinationType != "DESTINATION_BLOCKCHAIN" {
+				t.Errorf("unexpected withdrawal request: %+v", submitted)
+			}
+		})
+	}
+}
+
+func TestSafeWithdrawalAuditFailureBlocksSubmission(t *testing.T) {
+	txs := &fakeWithdrawals{}
+	guard, err := NewWithdrawalGuard(&WithdrawalServices{
+		Transactions: txs,
+		AddressBook:  &fakeAddressBook{entries: []*model.AddressBookEntry{{Id: "e1", Symbol: "BTC", Address: "bc1q"}}},
+		Financing:    &fakeFinancing{withdrawal: map[string]string{"BTC": "1"}},
+		Balances:     &fakeWalletBalance{balance: &model.Balance{Amount: "1"}},
+	}, &WithdrawalConfig{
+		Audit: func(ctx context.Context, d *WithdrawalDecision) error { return errors.New("audit log unavailable") },
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	guard.now = func() time.Time { return time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC) }
+
+	decision, err := guard.SafeWithdrawal(context.Background(), &SafeWithdrawalRequest{
+		BusinessId:  "payout-43",
+		PortfolioId: "p1",
+		WalletId:    "w1",
+		Symbol:      "BTC",
+		Amount:      decimal.RequireFromString("0.5"),
+		Destination: &model.BlockchainAddress{Address: "bc1q"},
+	})
+	if err == nil {
+		t.Fatal("expected audit error")
+	}
+	if !decision.Allowed || len(txs.submitted) != 0 {
+		t.Errorf("expected an allowed decision that was not submitted")
+	}
+}
+
+func TestSafeWithdrawalDailyLimit(t *testing.T) {
+	newGuard := func(txs *fakeWithdrawals) *WithdrawalGuard {
+		guard, err := NewWithdrawalGuard(&WithdrawalServices{
+			Transactions: txs,
+			AddressBook:  &fakeAddressBook{entries: []*model.AddressBookEntry{{Id: "e1", Symbol: "BTC", Address: "bc1q"}}},
+			Financing:    &fakeFinancing{withdrawal: map[string]string{"BTC": "100"}},
+			Balances:     &fakeWalletBalance{balance: &model.Balance{Amount: "100"}},
+		}, &WithdrawalConfig{
+			Policy: WithdrawalPolicy{DailyLimits: map[string]decimal.Decimal{"BTC": decimal.NewFromInt(10)}},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		guard.now = func() time.Time { return time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC) }
+		return guard
+	}
+	request := func(businessId string) *SafeWithdrawalRequest {
+		return &SafeWithdrawalRequest{
+			BusinessId:  businessId,
+			PortfolioId: "p1",
+			WalletId:    "w1",
+			Symbol:      "BTC",
+			Amount:      decimal.NewFromInt(6),
+			Destination: &model.BlockchainAddress{Address: "bc1q"},
+		}
+	}
+
+	t.Run("retry does not count its own submission", func(t *testing.T) {
+		txs := &fakeWithdrawals{today: []*model.Transaction{{
+			Id:             "t1",
+			Symbol:         "BTC",
+			Status:         model.TransactionStatusDone,
+			Amount:         "-6",
+			IdempotencyKey: WithdrawalIdempotencyKey("p1", "payout-1"),
+		}}}
+		guard := newGuard(txs)
+
+		decision, err := guard.SafeWithdrawal(context.Background(), request("payout-1"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !decision.WithdrawnToday.IsZero() {
+			t.Errorf("expected the earlier submission to be excluded, got %s", decision.WithdrawnToday)
+		}
+	})
+
+	t.Run("concurrent withdrawals share the limit", func(t *testing.T) {
+		txs := &fakeWithdrawals{recordSubmitted: true, listDelay: 20 * time.Millisecond}
+		guard := newGuard(txs)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = guard.SafeWithdrawal(context.Background(), request(fmt.Sprintf("payout-%d", i)))
+			}()
+		}
+		wg.Wait()
+
+		if len(txs.submitted) != 1 {
+			t.Fatalf("expected one submission, got %d", len(txs.submitted))
+		}
+		if violationType(errs[0]) != ViolationDailyLimit && violationType(errs[1]) != ViolationDailyLimit {
+			t.Errorf("expected a daily limit violation, got %v and %v", errs[0], errs[1])
+		}
+	})
+}
+
+func TestWithdrawalIdempotencyKey(t *testing.T) {
+	key := WithdrawalIdempotencyKey("p1", "payout-42")
+	if key != WithdrawalIdempotencyKey("p1", "payout-42") {
+		t.Error("expected a stable key")
+	}
+	if key == WithdrawalIdempotencyKey("p2", "payout-42") || key == WithdrawalIdempotencyKey("p1", "payout-43") {
+		t.Error("expected keys to differ by portfolio and business id")
+	}
+}
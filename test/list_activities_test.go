@@ -76,11 +76,11 @@ func TestListActivities(t *testing.T) {
 			t.Error("expected secondary type to be set")
 		}
 
-		if len(a.Created) == 0 {
+		if a.Created.IsZero() {
 			t.Error("expected created to be set")
 		}
 
-		if len(a.Updated) == 0 {
+		if a.Updated.IsZero() {
 			t.Error("expected updated to be set")
 		}
 
@@ -88,7 +88,7 @@ func TestListActivities(t *testing.T) {
 			if len(u.Action) == 0 {
 				t.Error("expected user action to be set")
 			}
-			if len(u.Timestamp) == 0 {
+			if u.Timestamp.IsZero() {
 				t.Error("expected timestamp to be set")
 			}
 		}
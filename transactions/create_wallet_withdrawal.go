@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/coinbase-samples/core-go"
+	"github.com/coinbase-samples/prime-sdk-go/blockchain"
 	"github.com/coinbase-samples/prime-sdk-go/client"
 	"github.com/coinbase-samples/prime-sdk-go/model"
 )
@@ -40,6 +41,11 @@ type CreateWalletWithdrawalPaymentMethod struct {
 	Id string `json:"payment_method_id"`
 }
 
+// Validate checks a blockchain destination offline, using its network when set and the symbol otherwise
+func (r *CreateWalletWithdrawalRequest) Validate() error {
+	return blockchain.ValidateBlockchainAddress(r.BlockchainAddress, r.Symbol)
+}
+
 type CreateWalletWithdrawalResponse struct {
 	ActivityId      string                         `json:"activity_id"`
 	ApprovalUrl     string                         `json:"approval_url"`
@@ -59,6 +65,10 @@ func (s *transactionsServiceImpl) CreateWalletWithdrawal(
 	request *CreateWalletWithdrawalRequest,
 ) (*CreateWalletWithdrawalResponse, error) {
 
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/portfolios/%s/wallets/%s/withdrawals",
 		request.PortfolioId,
 		request.SourceWalletId,
@@ -30,7 +30,7 @@ import (
 type ListPortfolioTransactionsRequest struct {
 	PortfolioId string                  `json:"portfolio_id"`
 	Symbols     string                  `json:"symbols"`
-	Types       []string                `json:"types"`
+	Types       []model.TransactionType `json:"types"`
 	Start       time.Time               `json:"start_time"`
 	End         time.Time               `json:"end_time"`
 	Pagination  *model.PaginationParams `json:"pagination_params"`
@@ -64,6 +64,53 @@ func (r *ListPortfolioTransactionsResponse) Iterator() *model.PageIterator[*List
 	}, r.serviceConfig)
 }
 
+// NewResumableListPortfolioTransactions returns a ResumableIterator that saves its progress in store under key.
+// If a checkpoint already exists for key, iteration resumes from it instead of request.
+func NewResumableListPortfolioTransactions(
+	service TransactionsService,
+	request *ListPortfolioTransactionsRequest,
+	key string,
+	store model.CheckpointStore,
+) *model.ResumableIterator[ListPortfolioTransactionsRequest, *ListPortfolioTransactionsResponse, *model.Transaction] {
+	return model.NewResumableIterator(
+		key,
+		store,
+		request,
+		service.ListPortfolioTransactions,
+		func(resp *ListPortfolioTransactionsResponse) []*model.Transaction {
+			return resp.Transactions
+		},
+		func(req *ListPortfolioTransactionsRequest, cursor string) *ListPortfolioTransactionsRequest {
+			next := *req
+			next.Pagination = model.PrepareNextPagination(req.Pagination, cursor)
+			return &next
+		},
+	).WithConfig(service.ServiceConfig())
+}
+
+// BackfillPortfolioTransactions lists transactions between request.Start and request.End by paging time windows
+// concurrently. Start is required and a zero End means now. Results are deduped by id and sorted by time.
+func BackfillPortfolioTransactions(
+	ctx context.Context,
+	service TransactionsService,
+	request *ListPortfolioTransactionsRequest,
+	config *model.BackfillConfig,
+) ([]*model.Transaction, error) {
+	return model.BackfillList(ctx, model.ListBackfill[ListPortfolioTransactionsRequest, *ListPortfolioTransactionsResponse, *model.Transaction]{
+		Start: request.Start,
+		End:   request.End,
+		Window: func(start, end time.Time) *ListPortfolioTransactionsRequest {
+			window := *request
+			window.Start, window.End, window.Pagination = start, end, model.FirstPage(request.Pagination)
+			return &window
+		},
+		List:  service.ListPortfolioTransactions,
+		Items: func(resp *ListPortfolioTransactionsResponse) []*model.Transaction { return resp.Transactions },
+		Id:    func(t *model.Transaction) string { return t.Id },
+		Time:  func(t *model.Transaction) time.Time { return t.Created.Time },
+	}, config)
+}
+
 func (s *transactionsServiceImpl) ListPortfolioTransactions(
 	ctx context.Context,
 	request *ListPortfolioTransactionsRequest,
@@ -80,7 +127,7 @@ func (s *transactionsServiceImpl) ListPortfolioTransactions(
 	}
 
 	for _, ty := range request.Types {
-		queryParams = core.AppendHttpQueryParam(queryParams, "types", ty)
+		queryParams = core.AppendHttpQueryParam(queryParams, "types", string(ty))
 	}
 
 	if !request.Start.IsZero() {
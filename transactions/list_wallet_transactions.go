@@ -31,7 +31,7 @@ type ListWalletTransactionsRequest struct {
 	PortfolioId string                  `json:"portfolio_id"`
 	WalletId    string                  `json:"wallet_id"`
 	Symbols     string                  `json:"symbols"`
-	Types       []string                `json:"types"`
+	Types       []model.TransactionType `json:"types"`
 	Start       time.Time               `json:"start_time"`
 	End         time.Time               `json:"end_time"`
 	Pagination  *model.PaginationParams `json:"pagination_params"`
@@ -84,7 +84,7 @@ func (s *transactionsServiceImpl) ListWalletTransactions(
 	}
 
 	for _, ty := range request.Types {
-		queryParams = core.AppendHttpQueryParam(queryParams, "types", ty)
+		queryParams = core.AppendHttpQueryParam(queryParams, "types", string(ty))
 	}
 
 	if !request.Start.IsZero() {
@@ -0,0 +1,327 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/activities"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+// WaitForTransactionOptions controls how WaitForTransaction polls GetTransaction and the approval activity
+type WaitForTransactionOptions struct {
+	// MinInterval is the polling interval used right after the transaction changes (default 1s)
+	MinInterval time.Duration
+	// MaxInterval caps the polling interval while the transaction is unchanged (default 30s)
+	MaxInterval time.Duration
+	// Backoff multiplies the interval after each poll without changes (default 1.5)
+	Backoff float64
+	// MaxConsecutiveErrors is the number of failed polls in a row tolerated before giving up (default 3)
+	MaxConsecutiveErrors int
+	// Activities, when set, finds the activity of the transaction with ListActivities and tracks its
+	// consensus with GetActivity until it passes
+	Activities activities.ActivitiesService
+	// DeadlineWarning is how long before the approval deadline OnDeadlineApproaching is called (default 15m)
+	DeadlineWarning time.Duration
+	// OnStatusChange is called when the transaction status changes. previous is nil on the first poll.
+	OnStatusChange func(previous, current *model.Transaction)
+	// OnApprovalChange is called when the activity is found and whenever its status, consensus or
+	// user actions change
+	OnApprovalChange func(approval *ApprovalProgress)
+	// OnDeadlineApproaching is called once when the approval deadline is within DeadlineWarning and
+	// consensus has not passed
+	OnDeadlineApproaching func(approval *ApprovalProgress)
+	// OnBlockchainIds is called when the transaction reports blockchain ids it did not report before
+	OnBlockchainIds func(tx *model.Transaction, added []string)
+}
+
+// DefaultWaitForTransactionOptions returns options that poll between 1s and 30s
+func DefaultWaitForTransactionOptions() *WaitForTransactionOptions {
+	return &WaitForTransactionOptions{
+		MinInterval:          time.Second,
+		MaxInterval:          30 * time.Second,
+		Backoff:              1.5,
+		MaxConsecutiveErrors: 3,
+		DeadlineWarning:      15 * time.Minute,
+	}
+}
+
+// ApprovalProgress is the consensus state of the activity of a transaction
+type ApprovalProgress struct {
+	ActivityId       string               `json:"activity_id"`
+	Status           model.ActivityStatus `json:"status"`
+	PassedConsensus  bool                 `json:"passed_consensus"`
+	ApprovalDeadline time.Time            `json:"approval_deadline"`
+	// UserActions are the approvals and rejections recorded so far
+	UserActions []*model.UserAction `json:"user_actions"`
+}
+
+// TimeRemaining returns the time left until the approval deadline, or zero when there is none
+func (p *ApprovalProgress) TimeRemaining(now time.Time) time.Duration {
+	if p.ApprovalDeadline.IsZero() {
+		return 0
+	}
+	return p.ApprovalDeadline.Sub(now)
+}
+
+// TransactionTransition records when WaitForTransaction first observed a status
+type TransactionTransition struct {
+	Status model.TransactionStatus `json:"status"`
+	Time   time.Time               `json:"time"`
+}
+
+// WaitForTransactionResult is the final state of a transaction tracked by WaitForTransaction
+type WaitForTransactionResult struct {
+	Transaction *model.Transaction `json:"transaction"`
+	// Approval is nil when no Activities service was given or the activity was not found
+	Approval      *ApprovalProgress       `json:"approval,omitempty"`
+	BlockchainIds []string                `json:"blockchain_ids"`
+	Transitions   []TransactionTransition `json:"transitions"`
+}
+
+// WaitForTransaction polls GetTransaction until the transaction reaches a terminal status, typically
+// after CreateWalletWithdrawal or CreateWalletTransfer. With opts.Activities set it also follows the
+// approval activity, reporting consensus progress and warning when the approval deadline approaches.
+// Polling backs off from MinInterval towards MaxInterval while nothing changes. It returns the
+// context error if ctx ends first.
+func WaitForTransaction(
+	ctx context.Context,
+	service TransactionsService,
+	portfolioId string,
+	transactionId string,
+	opts *WaitForTransactionOptions,
+) (*WaitForTransactionResult, error) {
+	cfg := *DefaultWaitForTransactionOptions()
+	if opts != nil {
+		cfg.Activities = opts.Activities
+		cfg.OnStatusChange = opts.OnStatusChange
+		cfg.OnApprovalChange = opts.OnApprovalChange
+		cfg.OnDeadlineApproaching = opts.OnDeadlineApproaching
+		cfg.OnBlockchainIds = opts.OnBlockchainIds
+		if opts.MinInterval > 0 {
+			cfg.MinInterval = opts.MinInterval
+		}
+		if opts.MaxInterval > 0 {
+			cfg.MaxInterval = opts.MaxInterval
+		}
+		if opts.Backoff >= 1 {
+			cfg.Backoff = opts.Backoff
+		}
+		if opts.MaxConsecutiveErrors > 0 {
+			cfg.MaxConsecutiveErrors = opts.MaxConsecutiveErrors
+		}
+		if opts.DeadlineWarning > 0 {
+			cfg.DeadlineWarning = opts.DeadlineWarning
+		}
+	}
+
+	var (
+		previous *model.Transaction
+		result   = &WaitForTransactionResult{BlockchainIds: []string{}}
+		warned   bool
+		failures int
+		interval = cfg.MinInterval
+		timer    = time.NewTimer(0)
+	)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		current, approval, err := pollTransaction(ctx, service, cfg.Activities, portfolioId, transactionId, result.Approval)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			failures++
+			if failures >= cfg.MaxConsecutiveErrors {
+				return nil, err
+			}
+			timer.Reset(interval)
+			continue
+		}
+		failures = 0
+
+		changed := previous == nil || previous.Status != current.Status
+		if changed {
+			result.Transitions = append(result.Transitions, TransactionTransition{Status: current.Status, Time: time.Now()})
+			if cfg.OnStatusChange != nil {
+				cfg.OnStatusChange(previous, current)
+			}
+		}
+
+		var added []string
+		for _, id := range current.BlockchainIds {
+			if len(id) > 0 && !slices.Contains(result.BlockchainIds, id) {
+				added = append(added, id)
+				result.BlockchainIds = append(result.BlockchainIds, id)
+			}
+		}
+		if len(added) > 0 {
+			changed = true
+			if cfg.OnBlockchainIds != nil {
+				cfg.OnBlockchainIds(current, added)
+			}
+		}
+
+		if approval != nil && approvalChanged(result.Approval, approval) {
+			changed = true
+			if cfg.OnApprovalChange != nil {
+				cfg.OnApprovalChange(approval)
+			}
+		}
+		if approval != nil {
+			result.Approval = approval
+			remaining := approval.TimeRemaining(time.Now())
+			if !warned && !approval.PassedConsensus && !approval.ApprovalDeadline.IsZero() &&
+				remaining <= cfg.DeadlineWarning && !current.Status.IsTerminal() {
+				warned = true
+				if cfg.OnDeadlineApproaching != nil {
+					cfg.OnDeadlineApproaching(approval)
+				}
+			}
+		}
+
+		result.Transaction = current
+		if current.Status.IsTerminal() {
+			return result, nil
+		}
+
+		if changed {
+			interval = cfg.MinInterval
+		} else {
+			interval = time.Duration(float64(interval) * cfg.Backoff)
+			if interval > cfg.MaxInterval {
+				interval = cfg.MaxInterval
+			}
+		}
+
+		previous = current
+		timer.Reset(interval)
+	}
+}
+
+// pollTransaction gets the transaction and, while consensus has not passed, its approval activity.
+// The activity is looked up by reference id until found and then fetched directly.
+func pollTransaction(
+	ctx context.Context,
+	service TransactionsService,
+	activitiesService activities.ActivitiesService,
+	portfolioId string,
+	transactionId string,
+	approval *ApprovalProgress,
+) (*model.Transaction, *ApprovalProgress, error) {
+	resp, err := service.GetTransaction(ctx, &GetTransactionRequest{PortfolioId: portfolioId, TransactionId: transactionId})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get transaction %s: %w", transactionId, err)
+	}
+	current := resp.Transaction
+	if current == nil {
+		return nil, nil, fmt.Errorf("transaction %s not returned", transactionId)
+	}
+
+	if activitiesService == nil || (approval != nil && (approval.PassedConsensus || approval.Status.IsTerminal())) {
+		return current, approval, nil
+	}
+
+	var activity *model.Activity
+	if approval != nil {
+		actResp, err := activitiesService.GetActivity(ctx, &activities.GetActivityRequest{PortfolioId: portfolioId, Id: approval.ActivityId})
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to get activity %s: %w", approval.ActivityId, err)
+		}
+		activity = actResp.Activity
+	} else {
+		if activity, err = findTransactionActivity(ctx, activitiesService, portfolioId, current); err != nil {
+			return nil, nil, err
+		}
+	}
+	if activity == nil {
+		return current, approval, nil
+	}
+
+	return current, newApprovalProgress(activity), nil
+}
+
+// findTransactionActivity lists transaction activities created since shortly before the transaction
+// and returns the one referencing it, or nil when there is none yet
+func findTransactionActivity(
+	ctx context.Context,
+	service activities.ActivitiesService,
+	portfolioId string,
+	tx *model.Transaction,
+) (*model.Activity, error) {
+	request := &activities.ListActivitiesRequest{
+		PortfolioId: portfolioId,
+		Categories:  []model.ActivityCategory{model.ActivityCategoryTransaction},
+	}
+	if !tx.Created.IsZero() {
+		request.Start = tx.Created.Add(-time.Minute)
+	}
+
+	resp, err := service.ListActivities(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list activities for transaction %s: %w", tx.Id, err)
+	}
+
+	found, err := resp.Iterator().WithConfig(nil).
+		WithFilter(func(a *model.Activity) bool { return a.ReferenceId == tx.Id }).
+		FetchAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list activities for transaction %s: %w", tx.Id, err)
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	return found[0], nil
+}
+
+func newApprovalProgress(a *model.Activity) *ApprovalProgress {
+	progress := &ApprovalProgress{
+		ActivityId:  a.Id,
+		Status:      a.Status,
+		UserActions: a.UserActions,
+	}
+
+	var consensus *model.Consensus
+	if a.TransactionMetadata != nil && a.TransactionMetadata.Consensus != nil {
+		consensus = a.TransactionMetadata.Consensus
+	} else if a.AccountMetadata != nil && a.AccountMetadata.Consensus != nil {
+		consensus = a.AccountMetadata.Consensus
+	}
+	if consensus != nil {
+		progress.PassedConsensus = consensus.PassedConsensus
+		progress.ApprovalDeadline = consensus.ApprovalDeadline.Time
+	}
+
+	return progress
+}
+
+func approvalChanged(previous, current *ApprovalProgress) bool {
+	return previous == nil ||
+		previous.Status != current.Status ||
+		previous.PassedConsensus != current.PassedConsensus ||
+		len(previous.UserActions) != len(current.UserActions)
+}
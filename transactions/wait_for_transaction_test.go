@@ -0,0 +1,185 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/activities"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+)
+
+type stubTransactionsService struct {
+	TransactionsService
+	getTransaction func(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+}
+
+func (s *stubTransactionsService) GetTransaction(ctx context.Context, r *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return s.getTransaction(ctx, r)
+}
+
+type stubActivitiesService struct {
+	activities.ActivitiesService
+	listActivities func(context.Context, *activities.ListActivitiesRequest) (*activities.ListActivitiesResponse, error)
+	getActivity    func(context.Context, *activities.GetActivityRequest) (*activities.GetActivityResponse, error)
+}
+
+func (s *stubActivitiesService) ListActivities(ctx context.Context, r *activities.ListActivitiesRequest) (*activities.ListActivitiesResponse, error) {
+	return s.listActivities(ctx, r)
+}
+
+func (s *stubActivitiesService) GetActivity(ctx context.Context, r *activities.GetActivityRequest) (*activities.GetActivityResponse, error) {
+	return s.getActivity(ctx, r)
+}
+
+func fastWaitOptions() *WaitForTransactionOptions {
+	return &WaitForTransactionOptions{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+}
+
+func TestWaitForTransactionTracksApprovalAndChain(t *testing.T) {
+	created := model.NewTimestamp(time.Now().Add(-time.Minute))
+	deadline := model.NewTimestamp(time.Now().Add(10 * time.Minute))
+
+	states := []*model.Transaction{
+		{Id: "t1", Status: model.TransactionStatusCreated, Created: created},
+		{Id: "t1", Status: model.TransactionStatusCreated, Created: created},
+		{Id: "t1", Status: model.TransactionStatusApproved, Created: created},
+		{Id: "t1", Status: model.TransactionStatusBroadcasting, Created: created, BlockchainIds: []string{"0xabc"}},
+		{Id: "t1", Status: model.TransactionStatusDone, Created: created, BlockchainIds: []string{"0xabc"}},
+	}
+	consensus := func(passed bool, actions int) *model.Activity {
+		a := &model.Activity{
+			Id:                  "a1",
+			ReferenceId:         "t1",
+			Status:              model.ActivityStatusProcessing,
+			TransactionMetadata: &model.TransactionsMetadata{Consensus: &model.Consensus{ApprovalDeadline: deadline, PassedConsensus: passed}},
+		}
+		for i := 0; i < actions; i++ {
+			a.UserActions = append(a.UserActions, &model.UserAction{Action: "APPROVE", UserId: "u"})
+		}
+		return a
+	}
+	activityStates := []*model.Activity{consensus(false, 1), consensus(true, 2)}
+
+	calls, listCalls, getCalls := 0, 0, 0
+	svc := &stubTransactionsService{
+		getTransaction: func(ctx context.Context, r *GetTransactionRequest) (*GetTransactionResponse, error) {
+			if r.PortfolioId != "p1" || r.TransactionId != "t1" {
+				t.Fatalf("unexpected request: %+v", r)
+			}
+			tx := states[calls]
+			calls++
+			return &GetTransactionResponse{Transaction: tx}, nil
+		},
+	}
+	acts := &stubActivitiesService{
+		listActivities: func(ctx context.Context, r *activities.ListActivitiesRequest) (*activities.ListActivitiesResponse, error) {
+			listCalls++
+			if !r.Start.Equal(created.Add(-time.Minute)) || r.Categories[0] != model.ActivityCategoryTransaction {
+				t.Fatalf("unexpected request: %+v", r)
+			}
+			found := []*model.Activity{{Id: "other", ReferenceId: "t0"}}
+			// The activity appears on the second poll
+			if listCalls > 1 {
+				found = append(found, consensus(false, 0))
+			}
+			return &activities.ListActivitiesResponse{Activities: found, Request: r}, nil
+		},
+		getActivity: func(ctx context.Context, r *activities.GetActivityRequest) (*activities.GetActivityResponse, error) {
+			if r.Id != "a1" {
+				t.Fatalf("unexpected activity id: %s", r.Id)
+			}
+			a := activityStates[getCalls]
+			getCalls++
+			return &activities.GetActivityResponse{Activity: a}, nil
+		},
+	}
+
+	var (
+		approvals []int
+		warnings  int
+		chainIds  []string
+	)
+	opts := fastWaitOptions()
+	opts.Activities = acts
+	opts.OnApprovalChange = func(p *ApprovalProgress) { approvals = append(approvals, len(p.UserActions)) }
+	opts.OnDeadlineApproaching = func(p *ApprovalProgress) { warnings++ }
+	opts.OnBlockchainIds = func(tx *model.Transaction, added []string) { chainIds = append(chainIds, added...) }
+
+	result, err := WaitForTransaction(context.Background(), svc, "p1", "t1", opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Transaction.Status != model.TransactionStatusDone {
+		t.Errorf("unexpected status: %s", result.Transaction.Status)
+	}
+	if len(result.Transitions) != 4 || result.Transitions[1].Status != model.TransactionStatusApproved {
+		t.Errorf("unexpected transitions: %+v", result.Transitions)
+	}
+	if len(approvals) != 3 || approvals[2] != 2 {
+		t.Errorf("unexpected approval changes: %v", approvals)
+	}
+	if !result.Approval.PassedConsensus || !result.Approval.ApprovalDeadline.Equal(deadline.Time) {
+		t.Errorf("unexpected approval: %+v", result.Approval)
+	}
+	if warnings != 1 {
+		t.Errorf("expected one deadline warning, got %d", warnings)
+	}
+	// Consensus passed on the fourth poll, so the activity is not fetched again
+	if listCalls != 2 || getCalls != 2 {
+		t.Errorf("expected 2 list and 2 get calls, got %d and %d", listCalls, getCalls)
+	}
+	if len(chainIds) != 1 || len(result.BlockchainIds) != 1 || result.BlockchainIds[0] != "0xabc" {
+		t.Errorf("unexpected blockchain ids: %v %v", chainIds, result.BlockchainIds)
+	}
+}
+
+func TestWaitForTransactionGivesUpAfterErrors(t *testing.T) {
+	calls := 0
+	svc := &stubTransactionsService{
+		getTransaction: func(ctx context.Context, r *GetTransactionRequest) (*GetTransactionResponse, error) {
+			calls++
+			return nil, errors.New("unavailable")
+		},
+	}
+
+	if _, err := WaitForTransaction(context.Background(), svc, "p1", "t1", fastWaitOptions()); err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestWaitForTransactionHonorsContext(t *testing.T) {
+	svc := &stubTransactionsService{
+		getTransaction: func(ctx context.Context, r *GetTransactionRequest) (*GetTransactionResponse, error) {
+			return &GetTransactionResponse{Transaction: &model.Transaction{Id: "t1", Status: model.TransactionStatusProcessing}}, nil
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := WaitForTransaction(ctx, svc, "p1", "t1", fastWaitOptions()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -67,7 +67,8 @@ func CalculateOrderSize(
 
 func AdjustOrderSize(amount, baseMin, baseMax, baseIncrement decimal.Decimal) decimal.Decimal {
 
-	if amount.Cmp(baseMax) > 0 {
+	// Products without a max size or increment report them empty, which parses as zero
+	if baseMax.IsPositive() && amount.Cmp(baseMax) > 0 {
 		return baseMax
 	}
 
@@ -75,6 +76,10 @@ func AdjustOrderSize(amount, baseMin, baseMax, baseIncrement decimal.Decimal) de
 		return decimal.NewFromFloat(0)
 	}
 
+	if !baseIncrement.IsPositive() {
+		return amount
+	}
+
 	quo, rem := amount.QuoRem(baseIncrement, 0)
 
 	if rem.IsZero() {
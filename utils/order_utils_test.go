@@ -107,31 +107,3 @@ func TestAdjustOrderSize(t *testing.T) {
 		})
 	}
 }
-
-/*
-func TestAdjustTwapLimitPrice(t *testing.T) {
-
-	cases := []struct {
-		description    string
-		price          decimal.Decimal
-		quoteIncrement decimal.Decimal
-		expected       decimal.Decimal
-	}{
-		{
-			description:    "AdjustTwapLimitPrice0",
-			price:          decimal.NewFromFloat(2000.2020222),
-			quoteIncrement: decimal.NewFromFloat(0.01),
-			expected:       decimal.NewFromFloat(2000.20),
-		},
-	}
-
-	for _, tt := range cases {
-		t.Run(tt.description, func(t *testing.T) {
-			result := adjustTwapLimitPrice(tt.price, tt.quoteIncrement)
-			if result.Cmp(tt.expected) != 0 {
-				t.Errorf("test: %s - expected: %v - received: %v", tt.description, tt.expected, result)
-			}
-		})
-	}
-}
-*/
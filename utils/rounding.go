@@ -0,0 +1,202 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode selects how values are rounded to a product increment
+type RoundingMode int
+
+const (
+	// RoundDown rounds towards negative infinity
+	RoundDown RoundingMode = iota
+	// RoundUp rounds towards positive infinity
+	RoundUp
+	// RoundHalfEven rounds to the nearest multiple, ties to the even multiple
+	RoundHalfEven
+	// RoundPassive rounds prices away from the touch, down for buys and up for sells, so
+	// rounding never makes an order more aggressive. Without a side it rounds down.
+	RoundPassive
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundDown:
+		return "DOWN"
+	case RoundUp:
+		return "UP"
+	case RoundHalfEven:
+		return "HALF_EVEN"
+	case RoundPassive:
+		return "PASSIVE"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(m))
+}
+
+// ErrNonPositivePrice is returned when converting between base and quote with a price that is not positive
+var ErrNonPositivePrice = errors.New("price must be greater than zero")
+
+// RoundToIncrement rounds value to a multiple of increment using mode. side is only used by
+// RoundPassive. Values are returned unchanged when increment is not positive.
+func RoundToIncrement(value, increment decimal.Decimal, mode RoundingMode, side model.OrderSide) decimal.Decimal {
+	if !increment.IsPositive() {
+		return value
+	}
+
+	if mode == RoundPassive {
+		mode = RoundDown
+		if side == model.OrderSideSell {
+			mode = RoundUp
+		}
+	}
+
+	quo, rem := value.QuoRem(increment, 0)
+	if rem.IsZero() {
+		return value
+	}
+
+	// QuoRem truncates towards zero, so the remainder has the sign of value
+	switch mode {
+	case RoundUp:
+		if rem.IsPositive() {
+			quo = quo.Add(decimal.NewFromInt(1))
+		}
+	case RoundHalfEven:
+		switch rem.Abs().Mul(decimal.NewFromInt(2)).Cmp(increment) {
+		case 1:
+			quo = quo.Add(decimal.NewFromInt(int64(rem.Sign())))
+		case 0:
+			if !quo.Mod(decimal.NewFromInt(2)).IsZero() {
+				quo = quo.Add(decimal.NewFromInt(int64(rem.Sign())))
+			}
+		}
+	default:
+		if rem.IsNegative() {
+			quo = quo.Sub(decimal.NewFromInt(1))
+		}
+	}
+
+	return quo.Mul(increment)
+}
+
+// RoundPrice rounds a limit or stop price to the product price increment, or to the quote
+// increment for products without one
+func RoundPrice(
+	product *model.Product,
+	price decimal.Decimal,
+	side model.OrderSide,
+	mode RoundingMode,
+) (decimal.Decimal, error) {
+	var (
+		increment decimal.Decimal
+		err       error
+	)
+
+	if len(product.PriceIncrement) > 0 {
+		increment, err = product.PriceIncrementNum()
+	} else {
+		increment, err = product.QuoteIncrementNum()
+	}
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return RoundToIncrement(price, increment, mode, side), nil
+}
+
+// AdjustQuoteSize rounds a quote-denominated order size to the product quote increment and
+// returns QuoteMaxSize when above it, or zero when below QuoteMinSize after rounding
+func AdjustQuoteSize(product *model.Product, value decimal.Decimal, mode RoundingMode) (decimal.Decimal, error) {
+	quoteMin, err := product.QuoteMinSizeNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	quoteMax, err := product.QuoteMaxSizeNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	quoteIncrement, err := product.QuoteIncrementNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if quoteMax.IsPositive() && value.GreaterThan(quoteMax) {
+		return quoteMax, nil
+	}
+
+	rounded := RoundToIncrement(value, quoteIncrement, mode, "")
+	if rounded.LessThan(quoteMin) || !rounded.IsPositive() {
+		return decimal.Zero, nil
+	}
+	if quoteMax.IsPositive() && rounded.GreaterThan(quoteMax) {
+		return quoteMax, nil
+	}
+
+	return rounded, nil
+}
+
+// BaseToQuote converts a base quantity to quote at price, rounded to the product quote increment
+func BaseToQuote(
+	product *model.Product,
+	base decimal.Decimal,
+	price decimal.Decimal,
+	mode RoundingMode,
+) (decimal.Decimal, error) {
+	if !price.IsPositive() {
+		return decimal.Zero, ErrNonPositivePrice
+	}
+
+	quoteIncrement, err := product.QuoteIncrementNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return RoundToIncrement(base.Mul(price), quoteIncrement, mode, ""), nil
+}
+
+// QuoteToBase converts a quote value to base at price, rounded to the product base increment
+func QuoteToBase(
+	product *model.Product,
+	quote decimal.Decimal,
+	price decimal.Decimal,
+	mode RoundingMode,
+) (decimal.Decimal, error) {
+	if !price.IsPositive() {
+		return decimal.Zero, ErrNonPositivePrice
+	}
+
+	baseIncrement, err := product.BaseIncrementNum()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	// Divide with enough precision that the division never decides the rounding
+	precision := int32(decimal.DivisionPrecision)
+	if exp := -baseIncrement.Exponent() + 8; exp > precision {
+		precision = exp
+	}
+
+	return RoundToIncrement(quote.DivRound(price, precision), baseIncrement, mode, ""), nil
+}
@@ -0,0 +1,164 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/shopspring/decimal"
+)
+
+func d(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func TestRoundToIncrement(t *testing.T) {
+	cases := []struct {
+		description string
+		value       string
+		increment   string
+		mode        RoundingMode
+		side        model.OrderSide
+		expected    string
+	}{
+		{"down", "100.237", "0.01", RoundDown, "", "100.23"},
+		{"up", "100.231", "0.01", RoundUp, "", "100.24"},
+		{"exact", "100.25", "0.05", RoundUp, "", "100.25"},
+		{"half even below", "100.224", "0.01", RoundHalfEven, "", "100.22"},
+		{"half even above", "100.226", "0.01", RoundHalfEven, "", "100.23"},
+		{"half even tie to even", "100.225", "0.01", RoundHalfEven, "", "100.22"},
+		{"half even tie odd", "100.235", "0.01", RoundHalfEven, "", "100.24"},
+		{"half even tie non-decimal increment", "0.75", "0.5", RoundHalfEven, "", "1"},
+		{"passive buy", "100.239", "0.01", RoundPassive, model.OrderSideBuy, "100.23"},
+		{"passive sell", "100.231", "0.01", RoundPassive, model.OrderSideSell, "100.24"},
+		{"passive no side", "100.239", "0.01", RoundPassive, "", "100.23"},
+		{"non-decimal increment", "12", "0.18", RoundDown, "", "11.88"},
+		{"non-decimal increment up", "12", "0.18", RoundUp, "", "12.06"},
+		{"negative down", "-1.231", "0.01", RoundDown, "", "-1.24"},
+		{"negative up", "-1.239", "0.01", RoundUp, "", "-1.23"},
+		{"zero increment", "1.2345", "0", RoundDown, "", "1.2345"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			result := RoundToIncrement(d(tt.value), d(tt.increment), tt.mode, tt.side)
+			if !result.Equal(d(tt.expected)) {
+				t.Errorf("expected: %s - received: %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestRoundPrice(t *testing.T) {
+	cases := []struct {
+		description string
+		product     model.Product
+		price       string
+		side        model.OrderSide
+		mode        RoundingMode
+		expected    string
+		err         bool
+	}{
+		{"price increment", model.Product{PriceIncrement: "0.5", QuoteIncrement: "0.01"}, "100.7", model.OrderSideBuy, RoundPassive, "100.5", false},
+		{"price increment sell", model.Product{PriceIncrement: "0.5", QuoteIncrement: "0.01"}, "100.2", model.OrderSideSell, RoundPassive, "100.5", false},
+		{"quote increment fallback", model.Product{QuoteIncrement: "0.01"}, "100.237", model.OrderSideSell, RoundHalfEven, "100.24", false},
+		{"twap limit price passive buy", model.Product{QuoteIncrement: "0.01"}, "2000.2020222", model.OrderSideBuy, RoundPassive, "2000.2", false},
+		{"invalid increment", model.Product{QuoteIncrement: "abc"}, "100", model.OrderSideBuy, RoundDown, "0", true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			result, err := RoundPrice(&tt.product, d(tt.price), tt.side, tt.mode)
+			if (err != nil) != tt.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Equal(d(tt.expected)) {
+				t.Errorf("expected: %s - received: %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAdjustQuoteSize(t *testing.T) {
+	product := &model.Product{QuoteMinSize: "10", QuoteMaxSize: "1000", QuoteIncrement: "0.01"}
+
+	cases := []struct {
+		description string
+		value       string
+		mode        RoundingMode
+		expected    string
+	}{
+		{"rounds down", "123.456", RoundDown, "123.45"},
+		{"rounds up", "123.451", RoundUp, "123.46"},
+		{"half even", "123.455", RoundHalfEven, "123.46"},
+		{"above max", "1500", RoundDown, "1000"},
+		{"rounded above max", "1000.001", RoundUp, "1000"},
+		{"below min", "9.99", RoundUp, "0"},
+		{"rounded below min", "10.001", RoundDown, "10"},
+		{"rounded to zero", "0.004", RoundDown, "0"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			result, err := AdjustQuoteSize(product, d(tt.value), tt.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Equal(d(tt.expected)) {
+				t.Errorf("expected: %s - received: %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestBaseQuoteConversion(t *testing.T) {
+	product := &model.Product{BaseIncrement: "0.0001", QuoteIncrement: "0.01"}
+
+	cases := []struct {
+		description string
+		convert     func(*model.Product, decimal.Decimal, decimal.Decimal, RoundingMode) (decimal.Decimal, error)
+		amount      string
+		price       string
+		mode        RoundingMode
+		expected    string
+	}{
+		{"base to quote down", BaseToQuote, "0.12345", "30001.17", RoundDown, "3703.64"},
+		{"base to quote up", BaseToQuote, "0.12345", "30001.17", RoundUp, "3703.65"},
+		{"quote to base down", QuoteToBase, "100", "3", RoundDown, "33.3333"},
+		{"quote to base up", QuoteToBase, "100", "3", RoundUp, "33.3334"},
+		{"quote to base half even", QuoteToBase, "100", "30000", RoundHalfEven, "0.0033"},
+		{"quote to base exact", QuoteToBase, "150", "30000", RoundUp, "0.005"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			result, err := tt.convert(product, d(tt.amount), d(tt.price), tt.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Equal(d(tt.expected)) {
+				t.Errorf("expected: %s - received: %s", tt.expected, result)
+			}
+		})
+	}
+
+	if _, err := QuoteToBase(product, d("100"), decimal.Zero, RoundDown); !errors.Is(err, ErrNonPositivePrice) {
+		t.Errorf("expected ErrNonPositivePrice, got %v", err)
+	}
+}
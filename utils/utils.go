@@ -24,8 +24,9 @@ import (
 	"github.com/coinbase-samples/prime-sdk-go/model"
 )
 
+// TimeToStr formats t as RFC3339 in UTC, keeping sub-second precision
 func TimeToStr(t time.Time) string {
-	return t.Format("2006-01-02T15:04:05Z")
+	return t.UTC().Format(time.RFC3339Nano)
 }
 
 func NewUuid() string {
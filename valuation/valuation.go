@@ -0,0 +1,374 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package valuation values the trading, vault and web3 balances of every portfolio in a
+// quote currency and reports per-portfolio and consolidated totals.
+package valuation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/coinbase-samples/prime-sdk-go/balances"
+	"github.com/coinbase-samples/prime-sdk-go/client"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/portfolios"
+	"github.com/coinbase-samples/prime-sdk-go/products"
+	"github.com/coinbase-samples/prime-sdk-go/wallets"
+	"github.com/shopspring/decimal"
+)
+
+// Source is the kind of wallet a balance is held in
+type Source string
+
+const (
+	SourceTrading Source = "TRADING"
+	SourceVault   Source = "VAULT"
+	SourceWeb3    Source = "WEB3"
+)
+
+// Amounts is a balance and its breakdown, either in units of an asset or valued in the quote currency
+type Amounts struct {
+	Total          decimal.Decimal `json:"total"`
+	Holds          decimal.Decimal `json:"holds"`
+	Bonded         decimal.Decimal `json:"bonded"`
+	Unbonding      decimal.Decimal `json:"unbonding"`
+	PendingRewards decimal.Decimal `json:"pending_rewards"`
+}
+
+func (a *Amounts) add(b Amounts) {
+	a.Total = a.Total.Add(b.Total)
+	a.Holds = a.Holds.Add(b.Holds)
+	a.Bonded = a.Bonded.Add(b.Bonded)
+	a.Unbonding = a.Unbonding.Add(b.Unbonding)
+	a.PendingRewards = a.PendingRewards.Add(b.PendingRewards)
+}
+
+func (a Amounts) mul(price decimal.Decimal) Amounts {
+	return Amounts{
+		Total:          a.Total.Mul(price),
+		Holds:          a.Holds.Mul(price),
+		Bonded:         a.Bonded.Mul(price),
+		Unbonding:      a.Unbonding.Mul(price),
+		PendingRewards: a.PendingRewards.Mul(price),
+	}
+}
+
+// Holding is one asset held in one kind of wallet
+type Holding struct {
+	Symbol string `json:"symbol"`
+	Source Source `json:"source"`
+	// Priced is false when no price was found; Value is then zero
+	Priced   bool            `json:"priced"`
+	Price    decimal.Decimal `json:"price"`
+	Quantity Amounts         `json:"quantity"`
+	Value    Amounts         `json:"value"`
+}
+
+// Totals are the quote currency value of a set of holdings
+type Totals struct {
+	Amounts
+	BySource map[Source]decimal.Decimal `json:"by_source"`
+}
+
+func (t *Totals) add(h *Holding) {
+	if t.BySource == nil {
+		t.BySource = make(map[Source]decimal.Decimal)
+	}
+	t.Amounts.add(h.Value)
+	t.BySource[h.Source] = t.BySource[h.Source].Add(h.Value.Total)
+}
+
+// PortfolioValuation is the valuation of one portfolio
+type PortfolioValuation struct {
+	PortfolioId string     `json:"portfolio_id"`
+	Name        string     `json:"name"`
+	EntityId    string     `json:"entity_id"`
+	Holdings    []*Holding `json:"holdings"`
+	Totals      Totals     `json:"totals"`
+}
+
+// Report is the valuation of every portfolio and their consolidated holdings
+type Report struct {
+	QuoteCurrency string                     `json:"quote_currency"`
+	At            time.Time                  `json:"at"`
+	Prices        map[string]decimal.Decimal `json:"prices"`
+	Portfolios    []*PortfolioValuation      `json:"portfolios"`
+	// Holdings are summed across portfolios by symbol and source
+	Holdings []*Holding `json:"holdings"`
+	Totals   Totals     `json:"totals"`
+	// Unpriced lists the symbols with a balance and no price, sorted
+	Unpriced []string `json:"unpriced"`
+}
+
+// ReportRequest configures BuildReport
+type ReportRequest struct {
+	// QuoteCurrency defaults to USD
+	QuoteCurrency string
+	// PortfolioIds restricts the report to these portfolios; all portfolios when empty
+	PortfolioIds []string
+	// Prices are asset prices in QuoteCurrency keyed by symbol. The quote currency is always priced at one.
+	Prices map[string]decimal.Decimal
+	// Products, when set, prices symbols missing from Prices with the latest candle close of
+	// the SYMBOL-QUOTE product. Symbols without such a product or candle are reported as unpriced;
+	// any other candle error fails the report.
+	Products products.ProductsService
+	// At is the time candles are taken at; defaults to now
+	At time.Time
+	// Granularity of candles; defaults to one minute
+	Granularity model.CandleGranularity
+	// Wallets, when set, adds the visible balances of every onchain wallet
+	Wallets wallets.WalletsService
+}
+
+// BuildReport lists portfolios, gathers their trading, vault and (when request.Wallets is set)
+// web3 balances, and values them in the quote currency. Holdings are sorted by symbol then source.
+func BuildReport(
+	ctx context.Context,
+	portfoliosService portfolios.PortfoliosService,
+	balancesService balances.BalancesService,
+	request *ReportRequest,
+) (*Report, error) {
+	quote := strings.ToUpper(request.QuoteCurrency)
+	if len(quote) == 0 {
+		quote = "USD"
+	}
+
+	at := request.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	granularity := request.Granularity
+	if len(granularity) == 0 {
+		granularity = model.CandleGranularityOneMinute
+	}
+
+	resp, err := portfoliosService.ListPortfolios(ctx, &portfolios.ListPortfoliosRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list portfolios: %w", err)
+	}
+
+	report := &Report{
+		QuoteCurrency: quote,
+		At:            at,
+		Prices:        map[string]decimal.Decimal{quote: decimal.NewFromInt(1)},
+	}
+	for symbol, price := range request.Prices {
+		report.Prices[strings.ToUpper(symbol)] = price
+	}
+
+	for _, p := range resp.Portfolios {
+		if len(request.PortfolioIds) > 0 && !slices.Contains(request.PortfolioIds, p.Id) {
+			continue
+		}
+
+		holdings, err := portfolioHoldings(ctx, balancesService, request.Wallets, p.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Portfolios = append(report.Portfolios, &PortfolioValuation{
+			PortfolioId: p.Id,
+			Name:        p.Name,
+			EntityId:    p.EntityId,
+			Holdings:    holdings,
+		})
+	}
+
+	unpriced := make(map[string]bool)
+	consolidated := make(map[string]*Holding)
+
+	for _, pv := range report.Portfolios {
+		for _, h := range pv.Holdings {
+			price, ok := report.Prices[h.Symbol]
+			if !ok && !unpriced[h.Symbol] && request.Products != nil {
+				// Candles are requested on behalf of the portfolio holding the asset
+				productId := h.Symbol + "-" + quote
+				marks, err := products.CandleMarks(ctx, request.Products, pv.PortfolioId, []string{productId}, at, granularity)
+				switch {
+				case err == nil:
+					price, ok = marks[productId], true
+					report.Prices[h.Symbol] = price
+				case !noCandle(err):
+					return nil, fmt.Errorf("unable to price %s: %w", h.Symbol, err)
+				}
+			}
+			if !ok {
+				unpriced[h.Symbol] = true
+			}
+
+			h.Priced, h.Price = ok, price
+			if ok {
+				h.Value = h.Quantity.mul(price)
+			}
+			pv.Totals.add(h)
+
+			key := h.Symbol + "/" + string(h.Source)
+			c, found := consolidated[key]
+			if !found {
+				c = &Holding{Symbol: h.Symbol, Source: h.Source, Priced: h.Priced, Price: h.Price}
+				consolidated[key] = c
+				report.Holdings = append(report.Holdings, c)
+			}
+			c.Quantity.add(h.Quantity)
+			c.Value.add(h.Value)
+		}
+	}
+
+	sortHoldings(report.Holdings)
+	for _, h := range report.Holdings {
+		report.Totals.add(h)
+	}
+
+	for symbol := range unpriced {
+		report.Unpriced = append(report.Unpriced, symbol)
+	}
+	sort.Strings(report.Unpriced)
+
+	return report, nil
+}
+
+// noCandle reports whether a candle lookup failed because the product has no candle or does not exist,
+// which leaves the symbol unpriced rather than failing the report
+func noCandle(err error) bool {
+	return errors.Is(err, products.ErrNoCandle) || client.HttpStatusCode(err) == http.StatusNotFound
+}
+
+func portfolioHoldings(
+	ctx context.Context,
+	balancesService balances.BalancesService,
+	walletsService wallets.WalletsService,
+	portfolioId string,
+) ([]*Holding, error) {
+	holdings := make(map[string]*Holding)
+	holding := func(symbol string, source Source) *Holding {
+		symbol = strings.ToUpper(symbol)
+		key := symbol + "/" + string(source)
+		h, ok := holdings[key]
+		if !ok {
+			h = &Holding{Symbol: symbol, Source: source}
+			holdings[key] = h
+		}
+		return h
+	}
+
+	for _, source := range []Source{SourceTrading, SourceVault} {
+		balanceType := model.BalanceTypeTrading
+		if source == SourceVault {
+			balanceType = model.BalanceTypeVault
+		}
+
+		resp, err := balancesService.ListPortfolioBalances(ctx, &balances.ListPortfolioBalancesRequest{
+			PortfolioId: portfolioId,
+			Type:        balanceType,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s balances for portfolio %s: %w", balanceType, portfolioId, err)
+		}
+
+		for _, b := range resp.Balances {
+			amounts, err := balanceAmounts(b)
+			if err != nil {
+				return nil, fmt.Errorf("portfolio %s: %w", portfolioId, err)
+			}
+			holding(b.Symbol, source).Quantity.add(amounts)
+		}
+	}
+
+	if walletsService != nil {
+		resp, err := walletsService.ListWallets(ctx, &wallets.ListWalletsRequest{
+			PortfolioId: portfolioId,
+			Type:        model.WalletTypeOnchain,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to list onchain wallets for portfolio %s: %w", portfolioId, err)
+		}
+
+		onchain, err := resp.Iterator().FetchAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list onchain wallets for portfolio %s: %w", portfolioId, err)
+		}
+
+		for _, w := range onchain {
+			resp, err := balancesService.ListOnchainWalletBalances(ctx, &balances.ListOnchainWalletBalancesRequest{
+				PortfolioId:        portfolioId,
+				WalletId:           w.Id,
+				VisibilityStatuses: []string{string(model.VisibilityStatusVisible)},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("unable to list balances for wallet %s: %w", w.Id, err)
+			}
+
+			web3Balances, err := resp.Iterator().FetchAll(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("unable to list balances for wallet %s: %w", w.Id, err)
+			}
+
+			for _, b := range web3Balances {
+				if b.Asset == nil || len(b.Asset.Symbol) == 0 {
+					continue
+				}
+				amount, err := b.AmountNum()
+				if err != nil {
+					return nil, fmt.Errorf("wallet %s: %w", w.Id, err)
+				}
+				holding(b.Asset.Symbol, SourceWeb3).Quantity.add(Amounts{Total: amount})
+			}
+		}
+	}
+
+	result := make([]*Holding, 0, len(holdings))
+	for _, h := range holdings {
+		result = append(result, h)
+	}
+	sortHoldings(result)
+
+	return result, nil
+}
+
+// balanceAmounts parses the amounts of a balance; empty fields are zero
+func balanceAmounts(b *model.Balance) (amounts Amounts, err error) {
+	if amounts.Total, err = b.AmountNum(); err != nil {
+		return
+	}
+	if amounts.Holds, err = b.HoldsNum(); err != nil {
+		return
+	}
+	if amounts.Bonded, err = b.BondedAmountNum(); err != nil {
+		return
+	}
+	if amounts.Unbonding, err = b.UnbondingAmountNum(); err != nil {
+		return
+	}
+	amounts.PendingRewards, err = b.PendingRewardsAmountNum()
+	return
+}
+
+func sortHoldings(holdings []*Holding) {
+	sort.Slice(holdings, func(i, j int) bool {
+		if holdings[i].Symbol != holdings[j].Symbol {
+			return holdings[i].Symbol < holdings[j].Symbol
+		}
+		return holdings[i].Source < holdings[j].Source
+	})
+}
@@ -0,0 +1,236 @@
+/**
+ * Copyright 2026-present Coinbase Global, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package valuation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/coinbase-samples/core-go"
+	"github.com/coinbase-samples/prime-sdk-go/balances"
+	"github.com/coinbase-samples/prime-sdk-go/client"
+	"github.com/coinbase-samples/prime-sdk-go/model"
+	"github.com/coinbase-samples/prime-sdk-go/portfolios"
+	"github.com/coinbase-samples/prime-sdk-go/products"
+	"github.com/coinbase-samples/prime-sdk-go/wallets"
+	"github.com/shopspring/decimal"
+)
+
+type fakePortfolios struct {
+	portfolios.PortfoliosService
+}
+
+func (f *fakePortfolios) ListPortfolios(ctx context.Context, r *portfolios.ListPortfoliosRequest) (*portfolios.ListPortfoliosResponse, error) {
+	return &portfolios.ListPortfoliosResponse{Portfolios: []*model.Portfolio{
+		{Id: "p1", Name: "Main", EntityId: "e1"},
+		{Id: "p2", Name: "Staking", EntityId: "e1"},
+	}}, nil
+}
+
+// fakeBalances returns balances keyed by portfolio id and balance type, and web3 balances keyed by wallet id
+type fakeBalances struct {
+	balances.BalancesService
+	portfolio map[string][]*model.Balance
+	web3      map[string][]*model.Web3Balance
+}
+
+func (f *fakeBalances) ListPortfolioBalances(ctx context.Context, r *balances.ListPortfolioBalancesRequest) (*balances.ListPortfolioBalancesResponse, error) {
+	return &balances.ListPortfolioBalancesResponse{Balances: f.portfolio[r.PortfolioId+"/"+r.Type]}, nil
+}
+
+func (f *fakeBalances) ListOnchainWalletBalances(ctx context.Context, r *balances.ListOnchainWalletBalancesRequest) (*balances.ListOnchainWalletBalancesResponse, error) {
+	return &balances.ListOnchainWalletBalancesResponse{Balances: f.web3[r.WalletId], Request: r}, nil
+}
+
+type fakeWallets struct {
+	wallets.WalletsService
+}
+
+func (f *fakeWallets) ListWallets(ctx context.Context, r *wallets.ListWalletsRequest) (*wallets.ListWalletsResponse, error) {
+	var found []*model.Wallet
+	if r.PortfolioId == "p1" && r.Type == model.WalletTypeOnchain {
+		found = append(found, &model.Wallet{Id: "w1"})
+	}
+	return &wallets.ListWalletsResponse{Wallets: found, Request: r}, nil
+}
+
+// fakeProducts has candles for ETH-USD only and fails with err when set
+type fakeProducts struct {
+	products.ProductsService
+	requested []string
+	err       error
+}
+
+func (f *fakeProducts) GetProductCandles(ctx context.Context, r *products.GetProductCandlesRequest) (*products.GetProductCandlesResponse, error) {
+	f.requested = append(f.requested, r.ProductId)
+	if f.err != nil {
+		return nil, f.err
+	}
+	if r.ProductId != "ETH-USD" {
+		return nil, &core.ApiError{Message: "product not found", CodeReceived: http.StatusNotFound}
+	}
+	return &products.GetProductCandlesResponse{Candles: []*model.Candle{
+		{Timestamp: model.NewTimestamp(r.EndTime.Add(-time.Minute)), Close: "2000"},
+	}}, nil
+}
+
+func TestBuildReport(t *testing.T) {
+	balancesService := &fakeBalances{
+		portfolio: map[string][]*model.Balance{
+			"p1/" + model.BalanceTypeTrading: {
+				{Symbol: "BTC", Amount: "2", Holds: "0.5"},
+				{Symbol: "USD", Amount: "1000", Holds: "100"},
+			},
+			"p1/" + model.BalanceTypeVault: {
+				{Symbol: "btc", Amount: "1"},
+			},
+			"p2/" + model.BalanceTypeVault: {
+				{Symbol: "ETH", Amount: "10", BondedAmount: "8", UnbondingAmount: "1", PendingRewardsAmount: "0.5"},
+				{Symbol: "BTC", Amount: "0.5"},
+			},
+		},
+		web3: map[string][]*model.Web3Balance{
+			"w1": {
+				{Asset: &model.Web3Asset{Symbol: "ETH", Network: "ethereum-mainnet"}, Amount: "1"},
+				{Asset: &model.Web3Asset{Symbol: "XYZ", Network: "ethereum-mainnet"}, Amount: "100"},
+			},
+		},
+	}
+	productsService := &fakeProducts{}
+
+	report, err := BuildReport(context.Background(), &fakePortfolios{}, balancesService, &ReportRequest{
+		Prices:   map[string]decimal.Decimal{"btc": decimal.NewFromInt(30000)},
+		Products: productsService,
+		Wallets:  &fakeWallets{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.QuoteCurrency != "USD" {
+		t.Errorf("expected quote currency USD, got %s", report.QuoteCurrency)
+	}
+	if len(report.Unpriced) != 1 || report.Unpriced[0] != "XYZ" {
+		t.Errorf("expected XYZ unpriced, got %v", report.Unpriced)
+	}
+	// ETH is priced from candles once, XYZ is attempted once
+	if len(productsService.requested) != 2 {
+		t.Errorf("expected 2 candle requests, got %v", productsService.requested)
+	}
+
+	totals := []struct {
+		description string
+		totals      Totals
+		expected    Amounts
+		bySource    map[Source]string
+	}{
+		{
+			description: "p1",
+			totals:      report.Portfolios[0].Totals,
+			expected: Amounts{
+				Total: decimal.NewFromInt(93000),
+				Holds: decimal.NewFromInt(15100),
+			},
+			bySource: map[Source]string{SourceTrading: "61000", SourceVault: "30000", SourceWeb3: "2000"},
+		},
+		{
+			description: "p2",
+			totals:      report.Portfolios[1].Totals,
+			expected: Amounts{
+				Total:          decimal.NewFromInt(35000),
+				Bonded:         decimal.NewFromInt(16000),
+				Unbonding:      decimal.NewFromInt(2000),
+				PendingRewards: decimal.NewFromInt(1000),
+			},
+			bySource: map[Source]string{SourceVault: "35000"},
+		},
+		{
+			description: "consolidated",
+			totals:      report.Totals,
+			expected: Amounts{
+				Total:          decimal.NewFromInt(128000),
+				Holds:          decimal.NewFromInt(15100),
+				Bonded:         decimal.NewFromInt(16000),
+				Unbonding:      decimal.NewFromInt(2000),
+				PendingRewards: decimal.NewFromInt(1000),
+			},
+			bySource: map[Source]string{SourceTrading: "61000", SourceVault: "65000", SourceWeb3: "2000"},
+		},
+	}
+
+	for _, tt := range totals {
+		t.Run(tt.description, func(t *testing.T) {
+			got := tt.totals.Amounts
+			if !got.Total.Equal(tt.expected.Total) || !got.Holds.Equal(tt.expected.Holds) ||
+				!got.Bonded.Equal(tt.expected.Bonded) || !got.Unbonding.Equal(tt.expected.Unbonding) ||
+				!got.PendingRewards.Equal(tt.expected.PendingRewards) {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+			for source, expected := range tt.bySource {
+				if !tt.totals.BySource[source].Equal(decimal.RequireFromString(expected)) {
+					t.Errorf("expected %s value %s, got %s", source, expected, tt.totals.BySource[source])
+				}
+			}
+		})
+	}
+
+	var vaultBtc *Holding
+	for _, h := range report.Holdings {
+		if h.Symbol == "BTC" && h.Source == SourceVault {
+			vaultBtc = h
+		}
+	}
+	if vaultBtc == nil || !vaultBtc.Quantity.Total.Equal(decimal.RequireFromString("1.5")) {
+		t.Errorf("expected consolidated vault BTC of 1.5, got %+v", vaultBtc)
+	}
+}
+
+func TestBuildReportFailsOnCandleErrors(t *testing.T) {
+	balancesService := &fakeBalances{portfolio: map[string][]*model.Balance{
+		"p1/" + model.BalanceTypeTrading: {{Symbol: "ETH", Amount: "1"}},
+	}}
+	productsService := &fakeProducts{err: &core.ApiError{Message: "unavailable", CodeReceived: http.StatusServiceUnavailable}}
+
+	_, err := BuildReport(context.Background(), &fakePortfolios{}, balancesService, &ReportRequest{Products: productsService})
+	if client.HttpStatusCode(err) != http.StatusServiceUnavailable {
+		t.Fatalf("expected the candle error, got %v", err)
+	}
+}
+
+func TestBuildReportFiltersPortfolios(t *testing.T) {
+	balancesService := &fakeBalances{portfolio: map[string][]*model.Balance{
+		"p1/" + model.BalanceTypeTrading: {{Symbol: "EUR", Amount: "10"}},
+		"p2/" + model.BalanceTypeTrading: {{Symbol: "EUR", Amount: "20"}},
+	}}
+
+	report, err := BuildReport(context.Background(), &fakePortfolios{}, balancesService, &ReportRequest{
+		QuoteCurrency: "eur",
+		PortfolioIds:  []string{"p2"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(report.Portfolios) != 1 || report.Portfolios[0].PortfolioId != "p2" {
+		t.Fatalf("expected only p2, got %+v", report.Portfolios)
+	}
+	if !report.Totals.Total.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("expected total 20, got %s", report.Totals.Total)
+	}
+}